@@ -0,0 +1,395 @@
+package authress
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AccessRecordInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &AccessRecordInterfaceProvider{}
+	_ resource.ResourceWithImportState = &AccessRecordInterfaceProvider{}
+)
+
+// NewAccessRecordResource is a helper function to simplify the provider implementation.
+func NewAccessRecordResource() resource.Resource {
+	return &AccessRecordInterfaceProvider{}
+}
+
+// AccessRecordInterfaceProvider is the resource implementation.
+type AccessRecordInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressAccessRecordResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String						`tfsdk:"id"`
+	RecordID	TerraformType.String						`tfsdk:"record_id"`
+	Name		TerraformType.String						`tfsdk:"name"`
+	Description	TerraformType.String						`tfsdk:"description"`
+	LastUpdated	TerraformType.String						`tfsdk:"last_updated"`
+	Users		TerraformType.List							`tfsdk:"users"`
+	Admins		TerraformType.List							`tfsdk:"admins"`
+	Groups		TerraformType.List							`tfsdk:"groups"`
+	Statements	[]AuthressAccessRecordStatementResource		`tfsdk:"statements"`
+}
+
+type AuthressAccessRecordStatementResource struct {
+	Roles		TerraformType.List	`tfsdk:"roles"`
+	Resources	TerraformType.List	`tfsdk:"resources"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *AccessRecordInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_record"
+}
+
+// Schema defines the schema for the data source.
+func (r *AccessRecordInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Access Record`. Access Records assign `Roles` to `Users` and `Groups` for specific `Resources`. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Access Record`. Access Records assign `Roles` to `Users` and `Groups` for specific `Resources`. See [Access Records](https://authress.io/knowledge-base/docs/authorization/access-records) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"record_id": schema.StringAttribute {
+				Description: "Unique identifier for the access record, can be specified on creation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^rec_[a-zA-Z0-9-._:@]+$`),
+						"must begin with the prefix rec_ and contain only alphanumeric characters and [-._:@]",
+					),
+				},
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the access record.",
+				Computed:   	true,
+			},
+			"name": schema.StringAttribute {
+				Description:	"A helpful name for this access record. The name displays in the Authress Management Portal",
+				Required:   	true,
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute {
+				Description:	"An extended description field that can be used to store additional information about the usage of the access record.",
+				Optional:	    true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+				Validators: 	[]validator.String{
+					stringvalidator.LengthBetween(0, 1024),
+				},
+			},
+			"users": schema.ListAttribute {
+				Description:	"The list of user IDs that are granted the statements in this access record.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.UseStateForUnknown() },
+			},
+			"admins": schema.ListAttribute {
+				Description:	"The list of user IDs that are allowed to manage this access record. When not specified, Authress sets the admins of the record automatically.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.UseStateForUnknown() },
+			},
+			"groups": schema.ListAttribute {
+				Description:	"The list of group IDs whose users are granted the statements in this access record.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.UseStateForUnknown() },
+			},
+			"statements": schema.ListNestedAttribute {
+				Description:	"The list of statements. Each statement grants all of its roles on all of its resources to the users and groups of the access record.",
+				Required:		true,
+				Validators:		[]validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: statementsNestedAttributeObject(),
+			},
+		},
+	}
+}
+
+// statementsNestedAttributeObject is the roles and resources of each statement in a statements list.
+func statementsNestedAttributeObject() (schema.NestedAttributeObject) {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute {
+			"roles": schema.ListAttribute {
+				Description:	"The list of role IDs granted by this statement, for example `ro_documents_admin`.",
+				ElementType:	TerraformType.StringType,
+				Required:		true,
+				Validators:		[]validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"resources": schema.ListAttribute {
+				Description:	"The list of resource URIs the roles apply to, for example `documents/doc_001` or `documents/*`.",
+				ElementType:	TerraformType.StringType,
+				Required:		true,
+				Validators:		[]validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *AccessRecordInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AccessRecordInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressAccessRecordResource AuthressAccessRecordResource
+	diags := req.Plan.Get(ctx, &plannedAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new access record
+	authressSdkRecord := MapTerraformAccessRecordToSdk(&plannedAuthressAccessRecordResource)
+	returnedRecord, err := r.client.CreateRecord(ctx, authressSdkRecord)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create access record:",
+			GetCreateApiErrorWrapper("Could not create access record", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressAccessRecordResource = MapSdkAccessRecordToTerraform(returnedRecord)
+	plannedAuthressAccessRecordResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccessRecordInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressAccessRecordResource AuthressAccessRecordResource
+	diags := req.State.Get(ctx, &currentAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed access record value from Authress
+	authressSdkRecord, err := r.client.GetRecord(ctx, currentAuthressAccessRecordResource.RecordID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get access record:",
+			GetApiErrorWrapper("Could not read Authress access record ID " + currentAuthressAccessRecordResource.RecordID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkRecord == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Access Record exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the access record in the Authress Management Portal or remove it from your state file. Record ID:" + currentAuthressAccessRecordResource.RecordID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Access Record", currentAuthressAccessRecordResource.RecordID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressAccessRecordResource
+	currentAuthressAccessRecordResource = MapSdkAccessRecordToTerraform(authressSdkRecord)
+	diags = resp.State.Set(ctx, &currentAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AccessRecordInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressAccessRecordResource AuthressAccessRecordResource
+	diags := req.Plan.Get(ctx, &plannedAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressAccessRecordResource
+	authressSdkRecord := MapTerraformAccessRecordToSdk(&plannedAuthressAccessRecordResource)
+
+	// Update existing access record
+	returnedRecord, err := r.client.UpdateRecord(ctx, plannedAuthressAccessRecordResource.RecordID.ValueString(), authressSdkRecord)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update access record:",
+			GetApiErrorWrapper("Could not update access record", err),
+		)
+		return
+	}
+
+	plannedAuthressAccessRecordResource = MapSdkAccessRecordToTerraform(returnedRecord)
+	plannedAuthressAccessRecordResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AccessRecordInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressAccessRecordResource AuthressAccessRecordResource
+	diags := req.State.Get(ctx, &currentAuthressAccessRecordResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing access record
+	err := r.client.DeleteRecord(ctx, currentAuthressAccessRecordResource.RecordID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete access record:",
+			GetApiErrorWrapper("Could not delete access record", err),
+		)
+		return
+	}
+}
+
+func (r *AccessRecordInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("record_id"), req, resp)
+}
+
+func MapSdkAccessRecordToTerraform(authressSdkRecord *AuthressSdk.AccessRecord) (AuthressAccessRecordResource) {
+	terraformRecord := AuthressAccessRecordResource {
+		RecordID: TerraformType.StringValue(authressSdkRecord.RecordID),
+		LegacyID: TerraformType.StringValue(authressSdkRecord.RecordID),
+		Name: TerraformType.StringValue(authressSdkRecord.Name),
+		Description: TerraformType.StringValue(authressSdkRecord.Description),
+		Statements: MapSdkStatementsToTerraform(authressSdkRecord.Statements),
+	}
+
+	userIDs := make([]string, 0, len(authressSdkRecord.Users))
+	for _, user := range authressSdkRecord.Users {
+		userIDs = append(userIDs, user.UserID)
+	}
+	terraformRecord.Users = MapSdkStringListToTerraform(userIDs)
+
+	adminIDs := make([]string, 0, len(authressSdkRecord.Admins))
+	for _, admin := range authressSdkRecord.Admins {
+		adminIDs = append(adminIDs, admin.UserID)
+	}
+	terraformRecord.Admins = MapSdkStringListToTerraform(adminIDs)
+
+	groupIDs := make([]string, 0, len(authressSdkRecord.Groups))
+	for _, group := range authressSdkRecord.Groups {
+		groupIDs = append(groupIDs, group.GroupID)
+	}
+	terraformRecord.Groups = MapSdkStringListToTerraform(groupIDs)
+
+	return terraformRecord
+}
+
+func MapTerraformAccessRecordToSdk(terraformRecord *AuthressAccessRecordResource) (AuthressSdk.AccessRecord) {
+	authressSdkRecord := AuthressSdk.AccessRecord {
+		RecordID: terraformRecord.RecordID.ValueString(),
+		Name: terraformRecord.Name.ValueString(),
+		Description: terraformRecord.Description.ValueString(),
+		Users: []AuthressSdk.User{},
+		Admins: []AuthressSdk.User{},
+		Groups: []AuthressSdk.LinkedGroup{},
+		Statements: MapTerraformStatementsToSdk(terraformRecord.Statements),
+	}
+
+	for _, userID := range MapTerraformStringListToSdk(terraformRecord.Users) {
+		authressSdkRecord.Users = append(authressSdkRecord.Users, AuthressSdk.User { UserID: userID })
+	}
+	for _, adminID := range MapTerraformStringListToSdk(terraformRecord.Admins) {
+		authressSdkRecord.Admins = append(authressSdkRecord.Admins, AuthressSdk.User { UserID: adminID })
+	}
+	for _, groupID := range MapTerraformStringListToSdk(terraformRecord.Groups) {
+		authressSdkRecord.Groups = append(authressSdkRecord.Groups, AuthressSdk.LinkedGroup { GroupID: groupID })
+	}
+
+	return authressSdkRecord
+}
+
+func MapSdkStatementsToTerraform(authressSdkStatements []AuthressSdk.Statement) ([]AuthressAccessRecordStatementResource) {
+	terraformStatements := make([]AuthressAccessRecordStatementResource, 0, len(authressSdkStatements))
+	for _, authressStatement := range authressSdkStatements {
+		resourceUris := make([]string, 0, len(authressStatement.Resources))
+		for _, authressResource := range authressStatement.Resources {
+			resourceUris = append(resourceUris, authressResource.ResourceURI)
+		}
+		terraformStatements = append(terraformStatements, AuthressAccessRecordStatementResource {
+			Roles: MapSdkStringListToTerraform(authressStatement.Roles),
+			Resources: MapSdkStringListToTerraform(resourceUris),
+		})
+	}
+
+	return terraformStatements
+}
+
+func MapTerraformStatementsToSdk(terraformStatements []AuthressAccessRecordStatementResource) ([]AuthressSdk.Statement) {
+	authressSdkStatements := make([]AuthressSdk.Statement, 0, len(terraformStatements))
+	for _, terraformStatement := range terraformStatements {
+		authressSdkStatement := AuthressSdk.Statement {
+			Roles: MapTerraformStringListToSdk(terraformStatement.Roles),
+			Resources: []AuthressSdk.Resource{},
+		}
+		for _, resourceUri := range MapTerraformStringListToSdk(terraformStatement.Resources) {
+			authressSdkStatement.Resources = append(authressSdkStatement.Resources, AuthressSdk.Resource { ResourceURI: resourceUri })
+		}
+		authressSdkStatements = append(authressSdkStatements, authressSdkStatement)
+	}
+
+	return authressSdkStatements
+}
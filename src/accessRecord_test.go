@@ -0,0 +1,113 @@
+package authress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccessRecordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_access_record" "test-1" {
+	record_id = "rec_test-1"
+	name = "Terraform Test Access Record"
+	users = ["test-user-1"]
+	statements = [
+		{
+			roles = ["ro_test-1"]
+			resources = ["documents/*"]
+		}
+	]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_access_record.test-1", "record_id", "rec_test-1"),
+					resource.TestCheckResourceAttr("authress_access_record.test-1", "users.0", "test-user-1"),
+					resource.TestCheckResourceAttr("authress_access_record.test-1", "statements.0.roles.0", "ro_test-1"),
+					resource.TestCheckResourceAttr("authress_access_record.test-1", "statements.0.resources.0", "documents/*"),
+					resource.TestCheckResourceAttrSet("authress_access_record.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_access_record.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_access_record" "test-1" {
+	record_id = "rec_test-1"
+	name = "Terraform Test Access Record Updated"
+	users = ["test-user-1", "test-user-2"]
+	statements = [
+		{
+			roles = ["ro_test-1"]
+			resources = ["documents/*"]
+		}
+	]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_access_record.test-1", "name", "Terraform Test Access Record Updated"),
+					resource.TestCheckResourceAttr("authress_access_record.test-1", "users.1", "test-user-2"),
+					resource.TestCheckResourceAttrSet("authress_access_record.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccessRecordResourceKeepsUnconfiguredPrincipals(t *testing.T) {
+	if testServer == nil {
+		t.Skip("Changing the access record outside of Terraform requires the in memory Authress API")
+	}
+
+	config := func(name string) (string) {
+		return providerConfig + fmt.Sprintf(`
+resource "authress_access_record" "test-2" {
+	record_id = "rec_test-2"
+	name = "%s"
+	statements = [
+		{
+			roles = ["ro_test-1"]
+			resources = ["documents/*"]
+		}
+	]
+}`, name)
+	}
+
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Terraform Test Access Record 2"),
+			},
+			// Users, groups and admins added outside of Terraform are kept when only the name changes
+			{
+				PreConfig: func() {
+					record, _ := testServer.Get("records", "rec_test-2")
+					record["users"] = []any{ map[string]any{ "userId": "portal-user" } }
+					record["admins"] = []any{ map[string]any{ "userId": "portal-admin" } }
+					record["groups"] = []any{ map[string]any{ "groupId": "grp_portal" } }
+					testServer.Set("records", record)
+				},
+				Config: config("Terraform Test Access Record 2 Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_access_record.test-2", "name", "Terraform Test Access Record 2 Updated"),
+					resource.TestCheckResourceAttr("authress_access_record.test-2", "users.0", "portal-user"),
+					resource.TestCheckResourceAttr("authress_access_record.test-2", "admins.0", "portal-admin"),
+					resource.TestCheckResourceAttr("authress_access_record.test-2", "groups.0", "grp_portal"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,235 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AccountIdentityInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &AccountIdentityInterfaceProvider{}
+	_ resource.ResourceWithImportState = &AccountIdentityInterfaceProvider{}
+)
+
+// NewAccountIdentityResource is a helper function to simplify the provider implementation.
+func NewAccountIdentityResource() resource.Resource {
+	return &AccountIdentityInterfaceProvider{}
+}
+
+// AccountIdentityInterfaceProvider is the resource implementation.
+type AccountIdentityInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressAccountIdentityResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String	`tfsdk:"id"`
+	IdentityID	TerraformType.String	`tfsdk:"identity_id"`
+	Issuer		TerraformType.String	`tfsdk:"issuer"`
+	Audience	TerraformType.String	`tfsdk:"audience"`
+	LastUpdated	TerraformType.String	`tfsdk:"last_updated"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *AccountIdentityInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_identity"
+}
+
+// Schema defines the schema for the data source.
+func (r *AccountIdentityInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a trusted identity of your Authress account. Tokens issued by a trusted identity provider, such as GitHub Actions, Google Workspace or another Authress account, are accepted by your Authress account. See Authress KB for more information.",
+		MarkdownDescription: "Manages a trusted identity of your Authress account. Tokens issued by a trusted identity provider, such as GitHub Actions, Google Workspace or another Authress account, are accepted by your Authress account. See [Linking Identity Providers](https://authress.io/knowledge-base/docs/category/cicd) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"identity_id": schema.StringAttribute {
+				Description:	"Unique identifier for the trusted identity, generated by Authress on creation.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the trusted identity.",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"issuer": schema.StringAttribute {
+				Description:	"The issuer URL of the trusted identity provider, for example `https://token.actions.githubusercontent.com`.",
+				Required:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"audience": schema.StringAttribute {
+				Description:	"The audience the tokens of the trusted identity provider must be issued for.",
+				Required:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *AccountIdentityInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AccountIdentityInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressAccountIdentityResource AuthressAccountIdentityResource
+	diags := req.Plan.Get(ctx, &plannedAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new trusted identity
+	authressSdkAccountIdentity := MapTerraformAccountIdentityToSdk(&plannedAuthressAccountIdentityResource)
+	returnedAccountIdentity, err := r.client.CreateAccountIdentity(ctx, authressSdkAccountIdentity)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create account identity:",
+			GetApiErrorWrapper("Could not create account identity", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressAccountIdentityResource = MapSdkAccountIdentityToTerraform(returnedAccountIdentity)
+	plannedAuthressAccountIdentityResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccountIdentityInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressAccountIdentityResource AuthressAccountIdentityResource
+	diags := req.State.Get(ctx, &currentAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed trusted identity value from Authress
+	authressSdkAccountIdentity, err := r.client.GetAccountIdentity(ctx, currentAuthressAccountIdentityResource.IdentityID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get account identity:",
+			GetApiErrorWrapper("Could not read Authress account identity ID " + currentAuthressAccountIdentityResource.IdentityID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkAccountIdentity == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Account Identity exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the account identity in the Authress Management Portal or remove it from your state file. Identity ID:" + currentAuthressAccountIdentityResource.IdentityID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Account Identity", currentAuthressAccountIdentityResource.IdentityID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressAccountIdentityResource
+	currentAuthressAccountIdentityResource = MapSdkAccountIdentityToTerraform(authressSdkAccountIdentity)
+	diags = resp.State.Set(ctx, &currentAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, every attribute change of the trusted identity requires a replacement.
+func (r *AccountIdentityInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedAuthressAccountIdentityResource AuthressAccountIdentityResource
+	diags := req.Plan.Get(ctx, &plannedAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plannedAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AccountIdentityInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressAccountIdentityResource AuthressAccountIdentityResource
+	diags := req.State.Get(ctx, &currentAuthressAccountIdentityResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing trusted identity
+	err := r.client.DeleteAccountIdentity(ctx, currentAuthressAccountIdentityResource.IdentityID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete account identity:",
+			GetApiErrorWrapper("Could not delete account identity", err),
+		)
+		return
+	}
+}
+
+func (r *AccountIdentityInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("identity_id"), req, resp)
+}
+
+func MapSdkAccountIdentityToTerraform(authressSdkAccountIdentity *AuthressSdk.AccountIdentity) (AuthressAccountIdentityResource) {
+	return AuthressAccountIdentityResource {
+		IdentityID: TerraformType.StringValue(authressSdkAccountIdentity.IdentityID),
+		LegacyID: TerraformType.StringValue(authressSdkAccountIdentity.IdentityID),
+		Issuer: TerraformType.StringValue(authressSdkAccountIdentity.Issuer),
+		Audience: TerraformType.StringValue(authressSdkAccountIdentity.Audience),
+	}
+}
+
+func MapTerraformAccountIdentityToSdk(terraformAccountIdentity *AuthressAccountIdentityResource) (AuthressSdk.AccountIdentity) {
+	return AuthressSdk.AccountIdentity {
+		Issuer: terraformAccountIdentity.Issuer.ValueString(),
+		Audience: terraformAccountIdentity.Audience.ValueString(),
+	}
+}
@@ -0,0 +1,37 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccountIdentityResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_account_identity" "test-1" {
+	issuer = "https://token.actions.githubusercontent.com"
+	audience = "https://github.com/authress-test"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_account_identity.test-1", "identity_id"),
+					resource.TestCheckResourceAttr("authress_account_identity.test-1", "issuer", "https://token.actions.githubusercontent.com"),
+					resource.TestCheckResourceAttrSet("authress_account_identity.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_account_identity.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
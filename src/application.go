@@ -0,0 +1,276 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ApplicationInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &ApplicationInterfaceProvider{}
+	_ resource.ResourceWithImportState = &ApplicationInterfaceProvider{}
+)
+
+// NewApplicationResource is a helper function to simplify the provider implementation.
+func NewApplicationResource() resource.Resource {
+	return &ApplicationInterfaceProvider{}
+}
+
+// ApplicationInterfaceProvider is the resource implementation.
+type ApplicationInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressApplicationResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID		TerraformType.String	`tfsdk:"id"`
+	ApplicationID	TerraformType.String	`tfsdk:"application_id"`
+	Name			TerraformType.String	`tfsdk:"name"`
+	Description		TerraformType.String	`tfsdk:"description"`
+	LastUpdated		TerraformType.String	`tfsdk:"last_updated"`
+	RedirectURLs	TerraformType.List		`tfsdk:"redirect_urls"`
+	AllowedOrigins	TerraformType.List		`tfsdk:"allowed_origins"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *ApplicationInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+// Schema defines the schema for the data source.
+func (r *ApplicationInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Application`. Applications are the frontends your users log in to, and restrict where Authress sends users and their tokens after login. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Application`. Applications are the frontends your users log in to, and restrict where Authress sends users and their tokens after login. See [Applications](https://authress.io/knowledge-base/docs/authentication/user-authentication/applications) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"application_id": schema.StringAttribute {
+				Description:	"Unique identifier for the application, generated by Authress on creation.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the application.",
+				Computed:   	true,
+			},
+			"name": schema.StringAttribute {
+				Description:	"A helpful name for this application. The name displays in the Authress Management Portal",
+				Required:   	true,
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute {
+				Description:	"An extended description field that can be used to store additional information about the usage of the application.",
+				Optional:	    true,
+				Computed:		true,
+				Validators: 	[]validator.String{
+					stringvalidator.LengthBetween(0, 1024),
+				},
+			},
+			"redirect_urls": schema.ListAttribute {
+				Description:	"The list of URLs users are allowed to be redirected to after logging in to the application.",
+				ElementType:	TerraformType.StringType,
+				Required:		true,
+				Validators:		[]validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"allowed_origins": schema.ListAttribute {
+				Description:	"The list of origins allowed to start a login and request user tokens for the application, for example `https://app.example.com`.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.UseStateForUnknown() },
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ApplicationInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ApplicationInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressApplicationResource AuthressApplicationResource
+	diags := req.Plan.Get(ctx, &plannedAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new application
+	authressSdkApplication := MapTerraformApplicationToSdk(&plannedAuthressApplicationResource)
+	returnedApplication, err := r.client.CreateApplication(ctx, authressSdkApplication)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create application:",
+			GetApiErrorWrapper("Could not create application", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressApplicationResource = MapSdkApplicationToTerraform(returnedApplication)
+	plannedAuthressApplicationResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ApplicationInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressApplicationResource AuthressApplicationResource
+	diags := req.State.Get(ctx, &currentAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed application value from Authress
+	authressSdkApplication, err := r.client.GetApplication(ctx, currentAuthressApplicationResource.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get application:",
+			GetApiErrorWrapper("Could not read Authress application ID " + currentAuthressApplicationResource.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkApplication == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Application exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the application in the Authress Management Portal or remove it from your state file. Application ID:" + currentAuthressApplicationResource.ApplicationID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Application", currentAuthressApplicationResource.ApplicationID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressApplicationResource
+	currentAuthressApplicationResource = MapSdkApplicationToTerraform(authressSdkApplication)
+	diags = resp.State.Set(ctx, &currentAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ApplicationInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressApplicationResource AuthressApplicationResource
+	diags := req.Plan.Get(ctx, &plannedAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressApplicationResource
+	authressSdkApplication := MapTerraformApplicationToSdk(&plannedAuthressApplicationResource)
+
+	// Update existing application
+	returnedApplication, err := r.client.UpdateApplication(ctx, plannedAuthressApplicationResource.ApplicationID.ValueString(), authressSdkApplication)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update application:",
+			GetApiErrorWrapper("Could not update application", err),
+		)
+		return
+	}
+
+	plannedAuthressApplicationResource = MapSdkApplicationToTerraform(returnedApplication)
+	plannedAuthressApplicationResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ApplicationInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressApplicationResource AuthressApplicationResource
+	diags := req.State.Get(ctx, &currentAuthressApplicationResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing application
+	err := r.client.DeleteApplication(ctx, currentAuthressApplicationResource.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete application:",
+			GetApiErrorWrapper("Could not delete application", err),
+		)
+		return
+	}
+}
+
+func (r *ApplicationInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), req, resp)
+}
+
+func MapSdkApplicationToTerraform(authressSdkApplication *AuthressSdk.Application) (AuthressApplicationResource) {
+	return AuthressApplicationResource {
+		ApplicationID: TerraformType.StringValue(authressSdkApplication.ApplicationID),
+		LegacyID: TerraformType.StringValue(authressSdkApplication.ApplicationID),
+		Name: TerraformType.StringValue(authressSdkApplication.Name),
+		Description: TerraformType.StringValue(authressSdkApplication.Description),
+		RedirectURLs: MapSdkStringListToTerraform(authressSdkApplication.RedirectURLs),
+		AllowedOrigins: MapSdkStringListToTerraform(authressSdkApplication.AllowedOrigins),
+	}
+}
+
+func MapTerraformApplicationToSdk(terraformApplication *AuthressApplicationResource) (AuthressSdk.Application) {
+	return AuthressSdk.Application {
+		Name: terraformApplication.Name.ValueString(),
+		Description: terraformApplication.Description.ValueString(),
+		RedirectURLs: MapTerraformStringListToSdk(terraformApplication.RedirectURLs),
+		AllowedOrigins: MapTerraformStringListToSdk(terraformApplication.AllowedOrigins),
+	}
+}
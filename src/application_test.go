@@ -0,0 +1,96 @@
+package authress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestApplicationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_application" "test-1" {
+	name = "Terraform Test Application"
+	redirect_urls = ["https://app.example.com/callback"]
+	allowed_origins = ["https://app.example.com"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_application.test-1", "application_id"),
+					resource.TestCheckResourceAttr("authress_application.test-1", "redirect_urls.0", "https://app.example.com/callback"),
+					resource.TestCheckResourceAttr("authress_application.test-1", "allowed_origins.0", "https://app.example.com"),
+					resource.TestCheckResourceAttrSet("authress_application.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_application.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_application" "test-1" {
+	name = "Terraform Test Application"
+	redirect_urls = ["https://app.example.com/callback", "https://staging.example.com/callback"]
+	allowed_origins = ["https://app.example.com", "https://staging.example.com"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_application.test-1", "redirect_urls.1", "https://staging.example.com/callback"),
+					resource.TestCheckResourceAttr("authress_application.test-1", "allowed_origins.1", "https://staging.example.com"),
+					resource.TestCheckResourceAttrSet("authress_application.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestApplicationResourceKeepsUnconfiguredOrigins(t *testing.T) {
+	if testServer == nil {
+		t.Skip("Changing the application outside of Terraform requires the in memory Authress API")
+	}
+
+	config := func(name string) (string) {
+		return providerConfig + fmt.Sprintf(`
+resource "authress_application" "test-2" {
+	name = "%s"
+	redirect_urls = ["https://app.example.com/callback"]
+}`, name)
+	}
+
+	var applicationID string
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Terraform Test Application 2"),
+				Check: func(state *terraform.State) (error) {
+					applicationID = state.RootModule().Resources["authress_application.test-2"].Primary.Attributes["application_id"]
+					return nil
+				},
+			},
+			// Origins set outside of Terraform are kept when only the name changes
+			{
+				PreConfig: func() {
+					application, _ := testServer.Get("applications", applicationID)
+					application["allowedOrigins"] = []any{ "https://portal.example.com" }
+					testServer.Set("applications", application)
+				},
+				Config: config("Terraform Test Application 2 Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_application.test-2", "name", "Terraform Test Application 2 Updated"),
+					resource.TestCheckResourceAttr("authress_application.test-2", "allowed_origins.0", "https://portal.example.com"),
+				),
+			},
+		},
+	})
+}
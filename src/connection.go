@@ -0,0 +1,334 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ConnectionInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &ConnectionInterfaceProvider{}
+	_ resource.ResourceWithImportState = &ConnectionInterfaceProvider{}
+)
+
+// NewConnectionResource is a helper function to simplify the provider implementation.
+func NewConnectionResource() resource.Resource {
+	return &ConnectionInterfaceProvider{}
+}
+
+// ConnectionInterfaceProvider is the resource implementation.
+type ConnectionInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressConnectionResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID			TerraformType.String	`tfsdk:"id"`
+	ConnectionID		TerraformType.String	`tfsdk:"connection_id"`
+	Type				TerraformType.String	`tfsdk:"type"`
+	Name				TerraformType.String	`tfsdk:"name"`
+	LastUpdated			TerraformType.String	`tfsdk:"last_updated"`
+	IssuerURL			TerraformType.String	`tfsdk:"issuer_url"`
+	AuthenticationURL	TerraformType.String	`tfsdk:"authentication_url"`
+	TokenURL			TerraformType.String	`tfsdk:"token_url"`
+	ClientID			TerraformType.String	`tfsdk:"client_id"`
+	ClientSecret		TerraformType.String	`tfsdk:"client_secret"`
+	ProviderCertificate	TerraformType.String	`tfsdk:"provider_certificate"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *ConnectionInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+// Schema defines the schema for the data source.
+func (r *ConnectionInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Connection`. Connections configure the OIDC and SAML identity providers your users log in with, and can be linked to `Tenants` for enterprise SSO. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Connection`. Connections configure the OIDC and SAML identity providers your users log in with, and can be linked to `Tenants` for enterprise SSO. See [Identity Connections](https://authress.io/knowledge-base/docs/authentication/connections) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"connection_id": schema.StringAttribute {
+				Description:	"Unique identifier for the connection, generated by Authress on creation.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the connection.",
+				Computed:   	true,
+			},
+			"type": schema.StringAttribute {
+				Description:	"The protocol of the identity provider, either `OAUTH2` for OpenID Connect providers or `SAML`.",
+				Required:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators:		[]validator.String{
+					stringvalidator.OneOf("OAUTH2", "SAML"),
+				},
+			},
+			"name": schema.StringAttribute {
+				Description:	"A helpful name for this connection. The name displays in the Authress Management Portal",
+				Optional:		true,
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"issuer_url": schema.StringAttribute {
+				Description:	"The issuer of the identity provider. For OIDC this is the `iss` of the provider's tokens, for SAML this is the entity ID of the identity provider.",
+				Optional:		true,
+			},
+			"authentication_url": schema.StringAttribute {
+				Description:	"The URL users are redirected to in order to log in. For OIDC this is the authorization endpoint, for SAML this is the single sign-on URL.",
+				Optional:		true,
+			},
+			"token_url": schema.StringAttribute {
+				Description:	"OIDC only. The token endpoint used to exchange the authorization code for the user's identity.",
+				Optional:		true,
+			},
+			"client_id": schema.StringAttribute {
+				Description:	"OIDC only. The client ID Authress uses to identify itself to the identity provider.",
+				Optional:		true,
+			},
+			"client_secret": schema.StringAttribute {
+				Description:	"OIDC only. The client secret Authress uses to authenticate with the identity provider. The secret is never returned by the Authress API, so changes made outside of Terraform are not detected.",
+				Optional:		true,
+				Sensitive:		true,
+			},
+			"provider_certificate": schema.StringAttribute {
+				Description:	"SAML only. The PEM encoded x509 signing certificate of the identity provider.",
+				Optional:		true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ConnectionInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ConnectionInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressConnectionResource AuthressConnectionResource
+	diags := req.Plan.Get(ctx, &plannedAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = maskConnectionSecrets(ctx, &plannedAuthressConnectionResource)
+	tflog.Debug(ctx, "Creating Authress connection")
+
+	// Create new connection
+	authressSdkConnection := MapTerraformConnectionToSdk(&plannedAuthressConnectionResource)
+	returnedConnection, err := r.client.CreateConnection(ctx, authressSdkConnection)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create connection:",
+			GetApiErrorWrapper("Could not create connection", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values, the secret is never returned by the API
+	clientSecret := plannedAuthressConnectionResource.ClientSecret
+	plannedAuthressConnectionResource = MapSdkConnectionToTerraform(returnedConnection)
+	plannedAuthressConnectionResource.ClientSecret = clientSecret
+	plannedAuthressConnectionResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ConnectionInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressConnectionResource AuthressConnectionResource
+	diags := req.State.Get(ctx, &currentAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed connection value from Authress
+	authressSdkConnection, err := r.client.GetConnection(ctx, currentAuthressConnectionResource.ConnectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get connection:",
+			GetApiErrorWrapper("Could not read Authress connection ID " + currentAuthressConnectionResource.ConnectionID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkConnection == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Connection exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the connection in the Authress Management Portal or remove it from your state file. Connection ID:" + currentAuthressConnectionResource.ConnectionID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Connection", currentAuthressConnectionResource.ConnectionID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressConnectionResource, keeping the secret which is never returned by the API
+	clientSecret := currentAuthressConnectionResource.ClientSecret
+	currentAuthressConnectionResource = MapSdkConnectionToTerraform(authressSdkConnection)
+	currentAuthressConnectionResource.ClientSecret = clientSecret
+	diags = resp.State.Set(ctx, &currentAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ConnectionInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressConnectionResource AuthressConnectionResource
+	diags := req.Plan.Get(ctx, &plannedAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = maskConnectionSecrets(ctx, &plannedAuthressConnectionResource)
+	tflog.Debug(ctx, "Updating Authress connection", map[string]any{"authress_connection_id": plannedAuthressConnectionResource.ConnectionID.ValueString()})
+
+	// Generate API request body from plannedAuthressConnectionResource
+	authressSdkConnection := MapTerraformConnectionToSdk(&plannedAuthressConnectionResource)
+
+	// Update existing connection
+	returnedConnection, err := r.client.UpdateConnection(ctx, plannedAuthressConnectionResource.ConnectionID.ValueString(), authressSdkConnection)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update connection:",
+			GetApiErrorWrapper("Could not update connection", err),
+		)
+		return
+	}
+
+	clientSecret := plannedAuthressConnectionResource.ClientSecret
+	plannedAuthressConnectionResource = MapSdkConnectionToTerraform(returnedConnection)
+	plannedAuthressConnectionResource.ClientSecret = clientSecret
+	plannedAuthressConnectionResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ConnectionInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressConnectionResource AuthressConnectionResource
+	diags := req.State.Get(ctx, &currentAuthressConnectionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing connection
+	err := r.client.DeleteConnection(ctx, currentAuthressConnectionResource.ConnectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete connection:",
+			GetApiErrorWrapper("Could not delete connection", err),
+		)
+		return
+	}
+}
+
+func (r *ConnectionInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+}
+
+// maskConnectionSecrets ensures the connection client secret never shows up in the provider logs.
+func maskConnectionSecrets(ctx context.Context, terraformConnection *AuthressConnectionResource) (context.Context) {
+	clientSecret := terraformConnection.ClientSecret.ValueString()
+	if clientSecret == "" {
+		return ctx
+	}
+
+	ctx = tflog.SetField(ctx, "authress_connection_client_secret", clientSecret)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "authress_connection_client_secret")
+	return tflog.MaskLogStrings(ctx, clientSecret)
+}
+
+func MapSdkConnectionToTerraform(authressSdkConnection *AuthressSdk.Connection) (AuthressConnectionResource) {
+	terraformConnection := AuthressConnectionResource {
+		ConnectionID: TerraformType.StringValue(authressSdkConnection.ConnectionID),
+		LegacyID: TerraformType.StringValue(authressSdkConnection.ConnectionID),
+		Type: TerraformType.StringValue(authressSdkConnection.Type),
+		Name: TerraformType.StringNull(),
+		IssuerURL: MapSdkOptionalStringToTerraform(authressSdkConnection.IssuerURL),
+		AuthenticationURL: MapSdkOptionalStringToTerraform(authressSdkConnection.AuthenticationURL),
+		TokenURL: MapSdkOptionalStringToTerraform(authressSdkConnection.TokenURL),
+		ClientID: MapSdkOptionalStringToTerraform(authressSdkConnection.ClientID),
+		ClientSecret: TerraformType.StringNull(),
+		ProviderCertificate: MapSdkOptionalStringToTerraform(authressSdkConnection.ProviderCertificate),
+	}
+
+	if authressSdkConnection.Data != nil {
+		terraformConnection.Name = MapSdkOptionalStringToTerraform(authressSdkConnection.Data.Name)
+	}
+
+	return terraformConnection
+}
+
+func MapTerraformConnectionToSdk(terraformConnection *AuthressConnectionResource) (AuthressSdk.Connection) {
+	authressSdkConnection := AuthressSdk.Connection {
+		Type: terraformConnection.Type.ValueString(),
+		IssuerURL: terraformConnection.IssuerURL.ValueString(),
+		AuthenticationURL: terraformConnection.AuthenticationURL.ValueString(),
+		TokenURL: terraformConnection.TokenURL.ValueString(),
+		ClientID: terraformConnection.ClientID.ValueString(),
+		ClientSecret: terraformConnection.ClientSecret.ValueString(),
+		ProviderCertificate: terraformConnection.ProviderCertificate.ValueString(),
+	}
+
+	if terraformConnection.Name.ValueString() != "" {
+		authressSdkConnection.Data = &AuthressSdk.ConnectionData {
+			Name: terraformConnection.Name.ValueString(),
+		}
+	}
+
+	return authressSdkConnection
+}
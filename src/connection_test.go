@@ -0,0 +1,61 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_connection" "test-1" {
+	type = "OAUTH2"
+	name = "Terraform Test Connection"
+	issuer_url = "https://idp.example.com"
+	authentication_url = "https://idp.example.com/authorize"
+	token_url = "https://idp.example.com/token"
+	client_id = "test-client"
+	client_secret = "test-secret"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_connection.test-1", "connection_id"),
+					resource.TestCheckResourceAttr("authress_connection.test-1", "type", "OAUTH2"),
+					resource.TestCheckResourceAttr("authress_connection.test-1", "client_secret", "test-secret"),
+					resource.TestCheckResourceAttrSet("authress_connection.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_connection.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, and the client_secret is never returned by it, therefore there is no value for them during import.
+				ImportStateVerifyIgnore: []string{"last_updated", "client_secret"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_connection" "test-1" {
+	type = "OAUTH2"
+	name = "Terraform Test Connection Updated"
+	issuer_url = "https://idp.example.com"
+	authentication_url = "https://idp.example.com/authorize"
+	token_url = "https://idp.example.com/token"
+	client_id = "test-client"
+	client_secret = "test-secret-2"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_connection.test-1", "name", "Terraform Test Connection Updated"),
+					resource.TestCheckResourceAttr("authress_connection.test-1", "client_secret", "test-secret-2"),
+					resource.TestCheckResourceAttrSet("authress_connection.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
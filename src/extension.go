@@ -0,0 +1,298 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ExtensionInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &ExtensionInterfaceProvider{}
+	_ resource.ResourceWithImportState = &ExtensionInterfaceProvider{}
+)
+
+// NewExtensionResource is a helper function to simplify the provider implementation.
+func NewExtensionResource() resource.Resource {
+	return &ExtensionInterfaceProvider{}
+}
+
+// ExtensionInterfaceProvider is the resource implementation.
+type ExtensionInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressExtensionResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID		TerraformType.String						`tfsdk:"id"`
+	ExtensionID		TerraformType.String						`tfsdk:"extension_id"`
+	Name			TerraformType.String						`tfsdk:"name"`
+	LastUpdated		TerraformType.String						`tfsdk:"last_updated"`
+	Application		*AuthressExtensionApplicationResource		`tfsdk:"application"`
+	Client			TerraformType.Object						`tfsdk:"client"`
+}
+
+type AuthressExtensionApplicationResource struct {
+	RedirectURLs	TerraformType.List	`tfsdk:"redirect_urls"`
+}
+
+// extensionClientAttributeTypes are the attribute types of the computed client object of an extension.
+var extensionClientAttributeTypes = map[string]attr.Type {
+	"client_id": TerraformType.StringType,
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *ExtensionInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extension"
+}
+
+// Schema defines the schema for the data source.
+func (r *ExtensionInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Extension`. Extensions are platform integrations you publish to your customers, with their own application login settings and client credentials. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Extension`. Extensions are platform integrations you publish to your customers, with their own application login settings and client credentials. See [Platform Extensions](https://authress.io/knowledge-base/docs/extensions) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"extension_id": schema.StringAttribute {
+				Description:	"Unique identifier for the extension, generated by Authress on creation.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the extension.",
+				Computed:   	true,
+			},
+			"name": schema.StringAttribute {
+				Description:	"A helpful name for this extension. The name displays in the Authress Management Portal",
+				Required:   	true,
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"application": schema.SingleNestedAttribute {
+				Description:	"The login configuration of the extension application, used when your customers' users log in to the extension.",
+				Required:		true,
+				Attributes: map[string]schema.Attribute {
+					"redirect_urls": schema.ListAttribute {
+						Description:	"The list of URLs users are allowed to be redirected to after logging in to the extension.",
+						ElementType:	TerraformType.StringType,
+						Required:		true,
+						Validators:		[]validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"client": schema.SingleNestedAttribute {
+				Description:	"The client credentials generated by Authress for the extension, used by the extension to request tokens.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.Object{ objectplanmodifier.UseStateForUnknown() },
+				Attributes: map[string]schema.Attribute {
+					"client_id": schema.StringAttribute {
+						Description:	"The client ID of the extension.",
+						Computed:		true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ExtensionInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ExtensionInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressExtensionResource AuthressExtensionResource
+	diags := req.Plan.Get(ctx, &plannedAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new extension
+	authressSdkExtension := MapTerraformExtensionToSdk(&plannedAuthressExtensionResource)
+	returnedExtension, err := r.client.CreateExtension(ctx, authressSdkExtension)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create extension:",
+			GetApiErrorWrapper("Could not create extension", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressExtensionResource = MapSdkExtensionToTerraform(returnedExtension)
+	plannedAuthressExtensionResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ExtensionInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressExtensionResource AuthressExtensionResource
+	diags := req.State.Get(ctx, &currentAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed extension value from Authress
+	authressSdkExtension, err := r.client.GetExtension(ctx, currentAuthressExtensionResource.ExtensionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get extension:",
+			GetApiErrorWrapper("Could not read Authress extension ID " + currentAuthressExtensionResource.ExtensionID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkExtension == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Extension exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the extension in the Authress Management Portal or remove it from your state file. Extension ID:" + currentAuthressExtensionResource.ExtensionID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Extension", currentAuthressExtensionResource.ExtensionID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressExtensionResource
+	currentAuthressExtensionResource = MapSdkExtensionToTerraform(authressSdkExtension)
+	diags = resp.State.Set(ctx, &currentAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ExtensionInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressExtensionResource AuthressExtensionResource
+	diags := req.Plan.Get(ctx, &plannedAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressExtensionResource
+	authressSdkExtension := MapTerraformExtensionToSdk(&plannedAuthressExtensionResource)
+
+	// Update existing extension
+	returnedExtension, err := r.client.UpdateExtension(ctx, plannedAuthressExtensionResource.ExtensionID.ValueString(), authressSdkExtension)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update extension:",
+			GetApiErrorWrapper("Could not update extension", err),
+		)
+		return
+	}
+
+	plannedAuthressExtensionResource = MapSdkExtensionToTerraform(returnedExtension)
+	plannedAuthressExtensionResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ExtensionInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressExtensionResource AuthressExtensionResource
+	diags := req.State.Get(ctx, &currentAuthressExtensionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing extension
+	err := r.client.DeleteExtension(ctx, currentAuthressExtensionResource.ExtensionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete extension:",
+			GetApiErrorWrapper("Could not delete extension", err),
+		)
+		return
+	}
+}
+
+func (r *ExtensionInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("extension_id"), req, resp)
+}
+
+func MapSdkExtensionToTerraform(authressSdkExtension *AuthressSdk.Extension) (AuthressExtensionResource) {
+	clientID := ""
+	if authressSdkExtension.Client != nil {
+		clientID = authressSdkExtension.Client.ClientID
+	}
+
+	return AuthressExtensionResource {
+		ExtensionID: TerraformType.StringValue(authressSdkExtension.ExtensionID),
+		LegacyID: TerraformType.StringValue(authressSdkExtension.ExtensionID),
+		Name: TerraformType.StringValue(authressSdkExtension.Name),
+		Application: &AuthressExtensionApplicationResource {
+			RedirectURLs: MapSdkStringListToTerraform(authressSdkExtension.Application.RedirectURLs),
+		},
+		Client: TerraformType.ObjectValueMust(extensionClientAttributeTypes, map[string]attr.Value {
+			"client_id": TerraformType.StringValue(clientID),
+		}),
+	}
+}
+
+func MapTerraformExtensionToSdk(terraformExtension *AuthressExtensionResource) (AuthressSdk.Extension) {
+	authressSdkExtension := AuthressSdk.Extension {
+		Name: terraformExtension.Name.ValueString(),
+	}
+
+	if terraformExtension.Application != nil {
+		authressSdkExtension.Application.RedirectURLs = MapTerraformStringListToSdk(terraformExtension.Application.RedirectURLs)
+	}
+
+	return authressSdkExtension
+}
@@ -0,0 +1,55 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestExtensionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_extension" "test-1" {
+	name = "Terraform Test Extension"
+	application = {
+		redirect_urls = ["https://extension.example.com/callback"]
+	}
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_extension.test-1", "extension_id"),
+					resource.TestCheckResourceAttr("authress_extension.test-1", "application.redirect_urls.0", "https://extension.example.com/callback"),
+					resource.TestCheckResourceAttrSet("authress_extension.test-1", "client.client_id"),
+					resource.TestCheckResourceAttrSet("authress_extension.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_extension.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_extension" "test-1" {
+	name = "Terraform Test Extension Updated"
+	application = {
+		redirect_urls = ["https://extension.example.com/callback", "https://extension.example.com/login"]
+	}
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_extension.test-1", "name", "Terraform Test Extension Updated"),
+					resource.TestCheckResourceAttr("authress_extension.test-1", "application.redirect_urls.1", "https://extension.example.com/login"),
+					resource.TestCheckResourceAttrSet("authress_extension.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,290 @@
+package authress
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &GroupInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &GroupInterfaceProvider{}
+	_ resource.ResourceWithImportState = &GroupInterfaceProvider{}
+)
+
+// NewGroupResource is a helper function to simplify the provider implementation.
+func NewGroupResource() resource.Resource {
+	return &GroupInterfaceProvider{}
+}
+
+// GroupInterfaceProvider is the resource implementation.
+type GroupInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressGroupResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String	`tfsdk:"id"`
+	GroupID		TerraformType.String	`tfsdk:"group_id"`
+	Name		TerraformType.String	`tfsdk:"name"`
+	LastUpdated	TerraformType.String	`tfsdk:"last_updated"`
+	Users		TerraformType.List		`tfsdk:"users"`
+	Admins		TerraformType.List		`tfsdk:"admins"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *GroupInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+// Schema defines the schema for the data source.
+func (r *GroupInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Group`. Groups contain a list of `Users` and can be referenced by `Access Records` to grant those users access. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Group`. Groups contain a list of `Users` and can be referenced by `Access Records` to grant those users access. See [Groups](https://authress.io/knowledge-base/docs/authorization/groups) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"group_id": schema.StringAttribute {
+				Description: "Unique identifier for the group, can be specified on creation, and used by access records to grant permissions to the users in the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^grp_[a-zA-Z0-9-._:@]+$`),
+						"must begin with the prefix grp_ and contain only alphanumeric characters and [-._:@]",
+					),
+				},
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the group.",
+				Computed:   	true,
+			},
+			"name": schema.StringAttribute {
+				Description:	"A helpful name for this group. The name displays in the Authress Management Portal",
+				Required:   	true,
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"users": schema.ListAttribute {
+				Description:	"The list of user IDs that are members of this group.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.UseStateForUnknown() },
+			},
+			"admins": schema.ListAttribute {
+				Description:	"The list of user IDs that are allowed to manage this group. When not specified, Authress sets the admins of the group automatically.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.UseStateForUnknown() },
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *GroupInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *GroupInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressGroupResource AuthressGroupResource
+	diags := req.Plan.Get(ctx, &plannedAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new group
+	authressSdkGroup := MapTerraformGroupToSdk(&plannedAuthressGroupResource)
+	returnedGroup, err := r.client.CreateGroup(ctx, authressSdkGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create group:",
+			GetCreateApiErrorWrapper("Could not create group", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressGroupResource = MapSdkGroupToTerraform(returnedGroup)
+	plannedAuthressGroupResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *GroupInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressGroupResource AuthressGroupResource
+	diags := req.State.Get(ctx, &currentAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed group value from Authress
+	authressSdkGroup, err := r.client.GetGroup(ctx, currentAuthressGroupResource.GroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get group:",
+			GetApiErrorWrapper("Could not read Authress group ID " + currentAuthressGroupResource.GroupID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkGroup == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Group exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the group in the Authress Management Portal or remove it from your state file. Group ID:" + currentAuthressGroupResource.GroupID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Group", currentAuthressGroupResource.GroupID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressGroupResource
+	currentAuthressGroupResource = MapSdkGroupToTerraform(authressSdkGroup)
+	diags = resp.State.Set(ctx, &currentAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *GroupInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressGroupResource AuthressGroupResource
+	diags := req.Plan.Get(ctx, &plannedAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressGroupResource
+	authressSdkGroup := MapTerraformGroupToSdk(&plannedAuthressGroupResource)
+
+	// Update existing group
+	returnedGroup, err := r.client.UpdateGroup(ctx, plannedAuthressGroupResource.GroupID.ValueString(), authressSdkGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update group:",
+			GetApiErrorWrapper("Could not update group", err),
+		)
+		return
+	}
+
+	plannedAuthressGroupResource = MapSdkGroupToTerraform(returnedGroup)
+	plannedAuthressGroupResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *GroupInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressGroupResource AuthressGroupResource
+	diags := req.State.Get(ctx, &currentAuthressGroupResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing group
+	err := r.client.DeleteGroup(ctx, currentAuthressGroupResource.GroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete group:",
+			GetApiErrorWrapper("Could not delete group", err),
+		)
+		return
+	}
+}
+
+func (r *GroupInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("group_id"), req, resp)
+}
+
+func MapSdkGroupToTerraform(authressSdkGroup *AuthressSdk.Group) (AuthressGroupResource) {
+	userIDs := make([]string, 0, len(authressSdkGroup.Users))
+	for _, user := range authressSdkGroup.Users {
+		userIDs = append(userIDs, user.UserID)
+	}
+
+	adminIDs := make([]string, 0, len(authressSdkGroup.Admins))
+	for _, admin := range authressSdkGroup.Admins {
+		adminIDs = append(adminIDs, admin.UserID)
+	}
+
+	return AuthressGroupResource {
+		GroupID: TerraformType.StringValue(authressSdkGroup.GroupID),
+		LegacyID: TerraformType.StringValue(authressSdkGroup.GroupID),
+		Name: TerraformType.StringValue(authressSdkGroup.Name),
+		Users: MapSdkStringListToTerraform(userIDs),
+		Admins: MapSdkStringListToTerraform(adminIDs),
+	}
+}
+
+func MapTerraformGroupToSdk(terraformGroup *AuthressGroupResource) (AuthressSdk.Group) {
+	authressSdkGroup := AuthressSdk.Group {
+		GroupID: terraformGroup.GroupID.ValueString(),
+		Name: terraformGroup.Name.ValueString(),
+		Users: []AuthressSdk.User{},
+		Admins: []AuthressSdk.User{},
+	}
+
+	for _, userID := range MapTerraformStringListToSdk(terraformGroup.Users) {
+		authressSdkGroup.Users = append(authressSdkGroup.Users, AuthressSdk.User { UserID: userID })
+	}
+	for _, adminID := range MapTerraformStringListToSdk(terraformGroup.Admins) {
+		authressSdkGroup.Admins = append(authressSdkGroup.Admins, AuthressSdk.User { UserID: adminID })
+	}
+
+	return authressSdkGroup
+}
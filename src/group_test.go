@@ -0,0 +1,91 @@
+package authress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_group" "test-1" {
+	group_id = "grp_test-1"
+	name = "Terraform Test Group"
+	users = ["test-user-1"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_group.test-1", "group_id", "grp_test-1"),
+					resource.TestCheckResourceAttr("authress_group.test-1", "users.0", "test-user-1"),
+					resource.TestCheckResourceAttrSet("authress_group.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_group.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_group" "test-1" {
+	group_id = "grp_test-1"
+	name = "Terraform Test Group Updated"
+	users = ["test-user-1", "test-user-2"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_group.test-1", "name", "Terraform Test Group Updated"),
+					resource.TestCheckResourceAttr("authress_group.test-1", "users.1", "test-user-2"),
+					resource.TestCheckResourceAttrSet("authress_group.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestGroupResourceKeepsUnconfiguredMembers(t *testing.T) {
+	if testServer == nil {
+		t.Skip("Changing the group outside of Terraform requires the in memory Authress API")
+	}
+
+	config := func(name string) (string) {
+		return providerConfig + fmt.Sprintf(`
+resource "authress_group" "test-2" {
+	group_id = "grp_test-2"
+	name = "%s"
+}`, name)
+	}
+
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Terraform Test Group 2"),
+			},
+			// Members and admins managed in the Authress Management Portal are kept when only the name changes
+			{
+				PreConfig: func() {
+					group, _ := testServer.Get("groups", "grp_test-2")
+					group["users"] = []any{ map[string]any{ "userId": "portal-user" } }
+					group["admins"] = []any{ map[string]any{ "userId": "portal-admin" } }
+					testServer.Set("groups", group)
+				},
+				Config: config("Terraform Test Group 2 Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_group.test-2", "name", "Terraform Test Group 2 Updated"),
+					resource.TestCheckResourceAttr("authress_group.test-2", "users.0", "portal-user"),
+					resource.TestCheckResourceAttr("authress_group.test-2", "admins.0", "portal-admin"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,234 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &InviteInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &InviteInterfaceProvider{}
+)
+
+// NewInviteResource is a helper function to simplify the provider implementation.
+func NewInviteResource() resource.Resource {
+	return &InviteInterfaceProvider{}
+}
+
+// InviteInterfaceProvider is the resource implementation.
+type InviteInterfaceProvider struct {
+	client *AuthressSdk.Client
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressInviteResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String						`tfsdk:"id"`
+	InviteID	TerraformType.String						`tfsdk:"invite_id"`
+	TenantID	TerraformType.String						`tfsdk:"tenant_id"`
+	Link		TerraformType.String						`tfsdk:"link"`
+	LastUpdated	TerraformType.String						`tfsdk:"last_updated"`
+	Statements	[]AuthressAccessRecordStatementResource		`tfsdk:"statements"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *InviteInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invite"
+}
+
+// Schema defines the schema for the data source.
+func (r *InviteInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Invite`. Invites grant their statements to the user that accepts them. Once an invite is accepted it no longer exists in Authress and is removed from the Terraform state. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Invite`. Invites grant their statements to the user that accepts them. Once an invite is accepted it no longer exists in Authress and is removed from the Terraform state. See [User Invites](https://authress.io/knowledge-base/docs/authorization/invites) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"invite_id": schema.StringAttribute {
+				Description:	"Unique identifier for the invite, generated by Authress on creation. Pass it to the user's login to accept the invite.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the invite.",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"link": schema.StringAttribute {
+				Description:	"The link to the invite returned by Authress.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"tenant_id": schema.StringAttribute {
+				Description:	"The tenant the user accepting the invite will be added to.",
+				Optional:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.RequiresReplace() },
+			},
+			"statements": schema.ListNestedAttribute {
+				Description:	"The list of statements. Each statement grants all of its roles on all of its resources to the user that accepts the invite.",
+				Required:		true,
+				PlanModifiers:	[]planmodifier.List{ listplanmodifier.RequiresReplace() },
+				Validators:		[]validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: statementsNestedAttributeObject(),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *InviteInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *InviteInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressInviteResource AuthressInviteResource
+	diags := req.Plan.Get(ctx, &plannedAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new invite
+	authressSdkInvite := MapTerraformInviteToSdk(&plannedAuthressInviteResource)
+	returnedInvite, err := r.client.CreateInvite(ctx, authressSdkInvite)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create invite:",
+			GetApiErrorWrapper("Could not create invite", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressInviteResource = MapSdkInviteToTerraform(returnedInvite)
+	plannedAuthressInviteResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *InviteInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressInviteResource AuthressInviteResource
+	diags := req.State.Get(ctx, &currentAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed invite value from Authress
+	authressSdkInvite, err := r.client.GetInvite(ctx, currentAuthressInviteResource.InviteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get invite:",
+			GetApiErrorWrapper("Could not read Authress invite ID " + currentAuthressInviteResource.InviteID.ValueString(), err),
+		)
+		return
+	}
+
+	// Accepting an invite deletes it, so a missing invite is expected and not an error
+	if authressSdkInvite == nil {
+		tflog.Info(ctx, "Authress invite no longer exists, it was accepted or deleted", map[string]any{"authress_invite_id": currentAuthressInviteResource.InviteID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed currentAuthressInviteResource
+	currentAuthressInviteResource = MapSdkInviteToTerraform(authressSdkInvite)
+	diags = resp.State.Set(ctx, &currentAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, every attribute change of the invite requires a replacement.
+func (r *InviteInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedAuthressInviteResource AuthressInviteResource
+	diags := req.Plan.Get(ctx, &plannedAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plannedAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *InviteInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressInviteResource AuthressInviteResource
+	diags := req.State.Get(ctx, &currentAuthressInviteResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing invite
+	err := r.client.DeleteInvite(ctx, currentAuthressInviteResource.InviteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete invite:",
+			GetApiErrorWrapper("Could not delete invite", err),
+		)
+		return
+	}
+}
+
+func MapSdkInviteToTerraform(authressSdkInvite *AuthressSdk.Invite) (AuthressInviteResource) {
+	terraformInvite := AuthressInviteResource {
+		InviteID: TerraformType.StringValue(authressSdkInvite.InviteID),
+		LegacyID: TerraformType.StringValue(authressSdkInvite.InviteID),
+		TenantID: MapSdkOptionalStringToTerraform(authressSdkInvite.TenantID),
+		Link: TerraformType.StringValue(""),
+		Statements: MapSdkStatementsToTerraform(authressSdkInvite.Statements),
+	}
+
+	if authressSdkInvite.Links != nil {
+		terraformInvite.Link = TerraformType.StringValue(authressSdkInvite.Links.Self)
+	}
+
+	return terraformInvite
+}
+
+func MapTerraformInviteToSdk(terraformInvite *AuthressInviteResource) (AuthressSdk.Invite) {
+	return AuthressSdk.Invite {
+		TenantID: terraformInvite.TenantID.ValueString(),
+		Statements: MapTerraformStatementsToSdk(terraformInvite.Statements),
+	}
+}
@@ -0,0 +1,49 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestInviteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_invite" "test-1" {
+	statements = [
+		{
+			roles = ["ro_test-1"]
+			resources = ["documents/*"]
+		}
+	]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_invite.test-1", "invite_id"),
+					resource.TestCheckResourceAttr("authress_invite.test-1", "statements.0.roles.0", "ro_test-1"),
+					resource.TestCheckResourceAttrSet("authress_invite.test-1", "last_updated"),
+				),
+			},
+			// Replace testing
+			{
+				Config: providerConfig + `
+resource "authress_invite" "test-1" {
+	statements = [
+		{
+			roles = ["ro_test-2"]
+			resources = ["documents/*"]
+		}
+	]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_invite.test-1", "invite_id"),
+					resource.TestCheckResourceAttr("authress_invite.test-1", "statements.0.roles.0", "ro_test-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
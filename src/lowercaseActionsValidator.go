@@ -0,0 +1,68 @@
+package authress
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LowercaseActionsValidator is a validator that warns about permission actions
+// in a permissions map that are not lowercase. Actions are case-insensitive and
+// Authress stores them lowercased, so a lowercase key always matches the action
+// returned by the Authress API. Actions that only differ by case are rejected,
+// because Authress would merge them into a single action.
+type LowercaseActionsValidator struct {}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v LowercaseActionsValidator) Description(ctx context.Context) string {
+    return "Permission actions should be lowercase and must not only differ by case"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v LowercaseActionsValidator) MarkdownDescription(ctx context.Context) string {
+    return "Permission actions should be lowercase and must not only differ by case"
+}
+
+// ValidateMap runs the logic of the validator, actions that are not lowercase only add warnings so that existing configurations keep working.
+func (v LowercaseActionsValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+
+    // Sorted so that the diagnostics are the same on every run
+    actions := make([]string, 0, len(req.ConfigValue.Elements()))
+    for action := range req.ConfigValue.Elements() {
+        actions = append(actions, action)
+    }
+    sort.Strings(actions)
+
+    configuredActions := map[string]string{}
+    for _, action := range actions {
+        lowercaseAction := strings.ToLower(action)
+        if configuredAction, exists := configuredActions[lowercaseAction]; exists {
+            resp.Diagnostics.AddAttributeError(
+                req.Path.AtMapKey(action),
+                "Duplicate permission action:",
+                "Permission actions are case-insensitive, the action \"" + action + "\" is the same action as \"" + configuredAction + "\". Configure each action only once.",
+            )
+            continue
+        }
+        configuredActions[lowercaseAction] = action
+
+        if action == lowercaseAction {
+            continue
+        }
+
+        resp.Diagnostics.AddAttributeWarning(
+            req.Path.AtMapKey(action),
+            "Permission action is not lowercase:",
+            "Permission actions are case-insensitive and are always stored lowercase by Authress. Use the action \"" + lowercaseAction + "\" instead of \"" + action + "\".",
+        )
+    }
+}
+
+func lowercaseActions() validator.Map {
+    return LowercaseActionsValidator {}
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -29,10 +32,33 @@ func New() provider.Provider {
 // authressProvider is the provider implementation.
 type authressProvider struct{}
 
+// AuthressProviderData is made available to the Configure method of every resource and data source.
+type AuthressProviderData struct {
+	Client		*AuthressSdk.Client
+	StrictRead	bool
+}
+
 // authressSdkTFModel maps provider schema data to a Go type.
 type authressSdkTFModel struct {
 	CustomDomain     TerraformType.String `tfsdk:"custom_domain"`
 	AccessKey 		 TerraformType.String `tfsdk:"access_key"`
+	MaxRetries		 TerraformType.Int64  `tfsdk:"max_retries"`
+	RequestTimeout	 TerraformType.Int64  `tfsdk:"request_timeout"`
+	ProxyURL		 TerraformType.String `tfsdk:"proxy_url"`
+	CABundleFile	 TerraformType.String `tfsdk:"ca_bundle_file"`
+	CABundle		 TerraformType.String `tfsdk:"ca_bundle"`
+	ClientCertificate TerraformType.String `tfsdk:"client_certificate"`
+	ClientKey		 TerraformType.String `tfsdk:"client_key"`
+	StrictRead		 TerraformType.Bool   `tfsdk:"strict_read"`
+	Oidc			 *authressOidcTFModel `tfsdk:"oidc"`
+}
+
+// authressOidcTFModel maps the oidc block to a Go type.
+type authressOidcTFModel struct {
+	TokenURL		TerraformType.String	`tfsdk:"token_url"`
+	ClientID		TerraformType.String	`tfsdk:"client_id"`
+	Audience		TerraformType.String	`tfsdk:"audience"`
+	TokenFile		TerraformType.String	`tfsdk:"token_file"`
 }
 
 // Metadata returns the provider type name.
@@ -54,6 +80,68 @@ func (p *authressProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional: 	true,
 				Sensitive: 	true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The number of times a request to the Authress API is retried when it is throttled or fails with a transient error. Defaults to 3, set to 0 to disable retries.",
+				Optional: 	true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "The time limit in seconds of a single request to the Authress API. Defaults to 10.",
+				Optional: 	true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "The URL of the proxy requests to the Authress API are sent through, for example `https://proxy.example.com:8080`. Defaults to the HTTPS_PROXY environment variable.",
+				Optional: 	true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Description: "Path to a file containing PEM encoded root certificates that are trusted in addition to the system root certificates, for networks that intercept TLS traffic.",
+				Optional: 	true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				Description: "PEM encoded root certificates that are trusted in addition to the system root certificates, for networks that intercept TLS traffic.",
+				Optional: 	true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to the Authress API or the proxy for mutual TLS. Requires the client_key.",
+				Optional: 	true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client_certificate.",
+				Optional: 	true,
+				Sensitive: 	true,
+			},
+			"strict_read": schema.BoolAttribute{
+				Description: "Fail when a resource in the state was deleted outside of Terraform, instead of removing it from the state and planning to recreate it. Defaults to false.",
+				Optional: 	true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oidc": schema.SingleNestedBlock{
+				Description: "Authenticate using the identity token of the CI/CD platform instead of an access key, so that no secret needs to be stored in the pipeline. The token is read from GitHub Actions or from a token file, such as GitLab's `CI_JOB_JWT_FILE`, and exchanged with Authress for an access token. The issuer of the token must be configured as a trusted identity of your Authress account.",
+				Attributes: map[string]schema.Attribute{
+					"token_file": schema.StringAttribute{
+						Description: "Path to a file containing the identity token. When not specified, the token is requested from GitHub Actions, or read from the file in the `CI_JOB_JWT_FILE` environment variable.",
+						Optional: 	true,
+					},
+					"audience": schema.StringAttribute{
+						Description: "The audience requested for the GitHub Actions identity token. Defaults to the custom domain.",
+						Optional: 	true,
+					},
+					"client_id": schema.StringAttribute{
+						Description: "The Authress client ID to request the access token for.",
+						Optional: 	true,
+					},
+					"token_url": schema.StringAttribute{
+						Description: "The Authress endpoint the identity token is exchanged at. Defaults to the token endpoint of the custom domain.",
+						Optional: 	true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -124,7 +212,16 @@ func (p *authressProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
-	if accessKey == "" {
+	if config.Oidc != nil && !config.AccessKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc"),
+			"Conflicting Authress API Authentication",
+			"Cannot connect to the Authress API: both an access_key and an oidc block are configured. "+
+				"Remove the access_key from the provider block to authenticate using the CI/CD identity token",
+		)
+	}
+
+	if accessKey == "" && config.Oidc == nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("access_key"),
 			"Missing Authress API Access Key",
@@ -137,6 +234,11 @@ func (p *authressProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// The CI/CD identity token replaces the access key
+	if config.Oidc != nil {
+		accessKey = ""
+	}
+
 	ctx = tflog.SetField(ctx, "authress_custom_domain", customDomain)
 	ctx = tflog.SetField(ctx, "authress_access_key", accessKey)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "authress_access_key")
@@ -144,7 +246,18 @@ func (p *authressProvider) Configure(ctx context.Context, req provider.Configure
 	tflog.Debug(ctx, "Creating Authress client")
 
 	// Create a new Authress client using the configuration values
-	client, err := AuthressSdk.NewClient(customDomain, accessKey, GetBuildInfo().Version)
+	transportOptions := AuthressSdk.TransportOptions{
+		ProxyURL: config.ProxyURL.ValueString(),
+		CABundleFile: config.CABundleFile.ValueString(),
+		CABundle: config.CABundle.ValueString(),
+		ClientCertificate: config.ClientCertificate.ValueString(),
+		ClientKey: config.ClientKey.ValueString(),
+	}
+	if !config.RequestTimeout.IsNull() {
+		transportOptions.RequestTimeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	client, err := AuthressSdk.NewClient(customDomain, accessKey, GetBuildInfo().Version, transportOptions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Authress API Client",
@@ -155,16 +268,42 @@ func (p *authressProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	if config.Oidc != nil {
+		tflog.Debug(ctx, "Authenticating with the CI/CD identity token")
+		oidcTokenProvider := AuthressSdk.NewOidcTokenProvider(customDomain, AuthressSdk.OidcConfiguration{
+			TokenURL: config.Oidc.TokenURL.ValueString(),
+			ClientID: config.Oidc.ClientID.ValueString(),
+			Audience: config.Oidc.Audience.ValueString(),
+			TokenFile: config.Oidc.TokenFile.ValueString(),
+		})
+		// The token exchange uses the same proxy and certificates as the Authress API
+		oidcTokenProvider.HTTPClient = client.HTTPClient
+		client.TokenProvider = oidcTokenProvider
+	}
+
+	if !config.MaxRetries.IsNull() {
+		client.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
 	// Make the Authress client available during DataSource and Resource type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &AuthressProviderData{
+		Client: client,
+		StrictRead: config.StrictRead.ValueBool(),
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 
 	tflog.Info(ctx, "Configured Authress client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *authressProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		// Linked to in the roleDataSource.go
+		NewRoleDataSource,
+		// Linked to in the rolesDataSource.go
+		NewRolesDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -172,5 +311,27 @@ func (p *authressProvider) Resources(_ context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		// Linked to in the role.go
 		NewRoleResource,
+		// Linked to in the accessRecord.go
+		NewAccessRecordResource,
+		// Linked to in the group.go
+		NewGroupResource,
+		// Linked to in the tenant.go
+		NewTenantResource,
+		// Linked to in the connection.go
+		NewConnectionResource,
+		// Linked to in the serviceClient.go
+		NewServiceClientResource,
+		// Linked to in the serviceClientAccessKey.go
+		NewServiceClientAccessKeyResource,
+		// Linked to in the application.go
+		NewApplicationResource,
+		// Linked to in the extension.go
+		NewExtensionResource,
+		// Linked to in the resourcePermission.go
+		NewResourcePermissionResource,
+		// Linked to in the invite.go
+		NewInviteResource,
+		// Linked to in the accountIdentity.go
+		NewAccountIdentityResource,
 	}
 }
@@ -1,11 +1,17 @@
 package authress
 
 import (
+	"fmt"
+	"os"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/authress/terraform-provider-authress/src/sdk/authresstest"
 )
 
-const (
+var (
 	// providerConfig is a shared configuration to combine with the actual test configuration so the Authress client is properly configured.
 	providerConfig = `
 provider "authress" {
@@ -13,6 +19,9 @@ provider "authress" {
 }`)
 
 var (
+	// testServer is the in memory Authress API, it is only running when the acceptance tests run offline.
+	testServer *authresstest.Server
+
 	// testAccProtoV6ProviderFactories are used to instantiate a provider during
 	// acceptance testing. The factory function will be invoked for every Terraform
 	// CLI command executed to create a provider server to which the CLI can
@@ -21,3 +30,22 @@ var (
 		"authress": providerserver.NewProtocol6WithError(New()),
 	}
 )
+
+// TestMain runs the acceptance tests offline against the in memory Authress API, unless an AUTHRESS_KEY for a real account is configured.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" || os.Getenv("AUTHRESS_KEY") != "" {
+		os.Exit(m.Run())
+	}
+
+	server := authresstest.NewServer()
+	testServer = server
+	providerConfig = fmt.Sprintf(`
+provider "authress" {
+  custom_domain     = "%s"
+  access_key        = "authresstest"
+}`, server.URL)
+
+	exitCode := m.Run()
+	server.Close()
+	os.Exit(exitCode)
+}
@@ -0,0 +1,257 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ResourcePermissionInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &ResourcePermissionInterfaceProvider{}
+	_ resource.ResourceWithImportState = &ResourcePermissionInterfaceProvider{}
+	_ resource.ResourceWithModifyPlan  = &ResourcePermissionInterfaceProvider{}
+)
+
+// NewResourcePermissionResource is a helper function to simplify the provider implementation.
+func NewResourcePermissionResource() resource.Resource {
+	return &ResourcePermissionInterfaceProvider{}
+}
+
+// ResourcePermissionInterfaceProvider is the resource implementation.
+type ResourcePermissionInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressResourcePermissionResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String						`tfsdk:"id"`
+	ResourceURI	TerraformType.String						`tfsdk:"resource_uri"`
+	LastUpdated	TerraformType.String						`tfsdk:"last_updated"`
+	Permissions	map[string]AuthressRolePermissionResource	`tfsdk:"permissions"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *ResourcePermissionInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_permission"
+}
+
+// Schema defines the schema for the data source.
+func (r *ResourcePermissionInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the permission configuration of an Authress `Resource`. The permissions apply to every user for the resource, for example to make a resource publicly readable. See Authress KB for more information.",
+		MarkdownDescription: "Manages the permission configuration of an Authress `Resource`. The permissions apply to every user for the resource, for example to make a resource publicly readable. See [Public Resources](https://authress.io/knowledge-base/docs/authorization/public-resources) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"resource_uri": schema.StringAttribute {
+				Description: "The URI of the resource the permissions apply to, for example `documents/doc_001`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the resource permissions.",
+				Computed:   	true,
+			},
+			"permissions": schema.MapNestedAttribute {
+				Description: "A map of the permissions every user has on the resource. The key of the map is the action the permission grants, can be scoped using `:` and parent actions imply sub-resource permissions, `action:*` or `action` implies `action:sub-action`. This property is case-insensitive, it will always be cast to lowercase before comparing actions to user permissions. Actions that only differ by case are rejected, and changing only the casing of an action is not a change. Lowercase actions are recommended.",
+				Required:	true,
+				Validators: permissionsMapValidators(),
+				NestedObject: permissionsNestedAttributeObject(),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ResourcePermissionInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ResourcePermissionInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressResourcePermissionResource AuthressResourcePermissionResource
+	diags := req.Plan.Get(ctx, &plannedAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resource permissions always exist in Authress, creating them sets the configuration
+	authressSdkResourcePermission := MapTerraformResourcePermissionToSdk(&plannedAuthressResourcePermissionResource)
+	returnedResourcePermission, err := r.client.UpdateResourcePermission(ctx, plannedAuthressResourcePermissionResource.ResourceURI.ValueString(), authressSdkResourcePermission)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create resource permissions:",
+			GetApiErrorWrapper("Could not create resource permissions", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedPermissions := plannedAuthressResourcePermissionResource.Permissions
+	plannedAuthressResourcePermissionResource = MapSdkResourcePermissionToTerraform(plannedAuthressResourcePermissionResource.ResourceURI.ValueString(), returnedResourcePermission)
+	plannedAuthressResourcePermissionResource.Permissions = PreservePermissionActionCasing(plannedAuthressResourcePermissionResource.Permissions, plannedPermissions)
+	plannedAuthressResourcePermissionResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ResourcePermissionInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressResourcePermissionResource AuthressResourcePermissionResource
+	diags := req.State.Get(ctx, &currentAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed resource permissions from Authress
+	authressSdkResourcePermission, err := r.client.GetResourcePermission(ctx, currentAuthressResourcePermissionResource.ResourceURI.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get resource permissions:",
+			GetApiErrorWrapper("Could not read Authress resource permissions for " + currentAuthressResourcePermissionResource.ResourceURI.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkResourcePermission == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Resource Permissions exist in the Terraform plan but do not exist in Authress:",
+				GetErrorWrapper("Either reconfigure the resource in the Authress Management Portal or remove it from your state file. Resource URI:" + currentAuthressResourcePermissionResource.ResourceURI.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Resource Permissions", currentAuthressResourcePermissionResource.ResourceURI.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressResourcePermissionResource
+	currentPermissions := currentAuthressResourcePermissionResource.Permissions
+	currentAuthressResourcePermissionResource = MapSdkResourcePermissionToTerraform(currentAuthressResourcePermissionResource.ResourceURI.ValueString(), authressSdkResourcePermission)
+	currentAuthressResourcePermissionResource.Permissions = PreservePermissionActionCasing(currentAuthressResourcePermissionResource.Permissions, currentPermissions)
+	diags = resp.State.Set(ctx, &currentAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ResourcePermissionInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressResourcePermissionResource AuthressResourcePermissionResource
+	diags := req.Plan.Get(ctx, &plannedAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressResourcePermissionResource
+	authressSdkResourcePermission := MapTerraformResourcePermissionToSdk(&plannedAuthressResourcePermissionResource)
+
+	// Update existing resource permissions
+	returnedResourcePermission, err := r.client.UpdateResourcePermission(ctx, plannedAuthressResourcePermissionResource.ResourceURI.ValueString(), authressSdkResourcePermission)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update resource permissions:",
+			GetApiErrorWrapper("Could not update resource permissions", err),
+		)
+		return
+	}
+
+	plannedPermissions := plannedAuthressResourcePermissionResource.Permissions
+	plannedAuthressResourcePermissionResource = MapSdkResourcePermissionToTerraform(plannedAuthressResourcePermissionResource.ResourceURI.ValueString(), returnedResourcePermission)
+	plannedAuthressResourcePermissionResource.Permissions = PreservePermissionActionCasing(plannedAuthressResourcePermissionResource.Permissions, plannedPermissions)
+	plannedAuthressResourcePermissionResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource permission configuration and removes the Terraform state on success.
+func (r *ResourcePermissionInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressResourcePermissionResource AuthressResourcePermissionResource
+	diags := req.State.Get(ctx, &currentAuthressResourcePermissionResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resource permissions cannot be deleted, clearing them restores the default configuration
+	_, err := r.client.UpdateResourcePermission(ctx, currentAuthressResourcePermissionResource.ResourceURI.ValueString(), AuthressSdk.ResourcePermission {
+		Permissions: []AuthressSdk.Permission{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete resource permissions:",
+			GetApiErrorWrapper("Could not delete resource permissions", err),
+		)
+		return
+	}
+}
+
+func (r *ResourcePermissionInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("resource_uri"), req, resp)
+}
+
+// ModifyPlan treats permission actions that only differ by case as equal.
+func (r *ResourcePermissionInterfaceProvider) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ModifyPlanPermissionActionCasing(ctx, req, resp)
+}
+
+func MapSdkResourcePermissionToTerraform(resourceURI string, authressSdkResourcePermission *AuthressSdk.ResourcePermission) (AuthressResourcePermissionResource) {
+	return AuthressResourcePermissionResource {
+		ResourceURI: TerraformType.StringValue(resourceURI),
+		LegacyID: TerraformType.StringValue(resourceURI),
+		Permissions: MapSdkPermissionsToTerraform(authressSdkResourcePermission.Permissions),
+	}
+}
+
+func MapTerraformResourcePermissionToSdk(terraformResourcePermission *AuthressResourcePermissionResource) (AuthressSdk.ResourcePermission) {
+	return AuthressSdk.ResourcePermission {
+		Permissions: MapTerraformPermissionsToSdk(terraformResourcePermission.Permissions),
+	}
+}
@@ -0,0 +1,60 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourcePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_resource_permission" "test-1" {
+	resource_uri = "public-documents"
+	permissions = {
+		"documents:read" = {
+			"allow" = true
+		}
+	}
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_resource_permission.test-1", "resource_uri", "public-documents"),
+					resource.TestCheckResourceAttr("authress_resource_permission.test-1", "permissions.documents:read.allow", "true"),
+					resource.TestCheckResourceAttrSet("authress_resource_permission.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_resource_permission.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_resource_permission" "test-1" {
+	resource_uri = "public-documents"
+	permissions = {
+		"documents:read" = {
+			"allow" = true
+		}
+		"documents:list" = {
+			"allow" = true
+		}
+	}
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_resource_permission.test-1", "permissions.documents:list.allow", "true"),
+					resource.TestCheckResourceAttrSet("authress_resource_permission.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
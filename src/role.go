@@ -2,12 +2,14 @@ package authress
 
 import (
 	"context"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                = &RoleInterfaceProvider{}
 	_ resource.ResourceWithConfigure   = &RoleInterfaceProvider{}
 	_ resource.ResourceWithImportState = &RoleInterfaceProvider{}
+	_ resource.ResourceWithModifyPlan  = &RoleInterfaceProvider{}
 )
 
 // NewRoleResource is a helper function to simplify the provider implementation.
@@ -34,6 +37,7 @@ func NewRoleResource() resource.Resource {
 // RoleInterfaceProvider is the resource implementation.
 type RoleInterfaceProvider struct {
 	client *AuthressSdk.Client
+	strictRead bool
 }
 
 /*******************************************/
@@ -46,6 +50,7 @@ type AuthressRoleResource struct {
 	Name 		TerraformType.String						`tfsdk:"name"`
 	Description TerraformType.String						`tfsdk:"description"`
 	LastUpdated TerraformType.String  						`tfsdk:"last_updated"`
+	Version		TerraformType.String						`tfsdk:"version"`
 	Permissions map[string]AuthressRolePermissionResource	`tfsdk:"permissions"`
 }
 
@@ -89,6 +94,10 @@ func (r *RoleInterfaceProvider) Schema(_ context.Context, _ resource.SchemaReque
 				Description:	"Timestamp of the last Terraform update of the role.",
 				Computed:   	true,
 			},
+			"version": schema.StringAttribute {
+				Description:	"The version of the role when it was last read from Authress. Updates are rejected when the role was changed outside of Terraform since then.",
+				Computed:   	true,
+			},
 			"name": schema.StringAttribute {
 				Description:	"A helpful name for this role. The name displays in the Authress Management Portal",
 				Required:   	true,
@@ -105,39 +114,50 @@ func (r *RoleInterfaceProvider) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"permissions": schema.MapNestedAttribute {
-				Description: "A map of the permissions. The key of the map is the action the permission grants, can be scoped using `:` and parent actions imply sub-resource permissions, `action:*` or `action` implies `action:sub-action`. This property is case-insensitive, it will always be cast to lowercase before comparing actions to user permissions.",
+				Description: "A map of the permissions. The key of the map is the action the permission grants, can be scoped using `:` and parent actions imply sub-resource permissions, `action:*` or `action` implies `action:sub-action`. This property is case-insensitive, it will always be cast to lowercase before comparing actions to user permissions. Actions that only differ by case are rejected, and changing only the casing of an action is not a change. Lowercase actions are recommended.",
 				Required:	true,
-				Validators: []validator.Map{
-					mapvalidator.KeysAre(
-						stringvalidator.LengthBetween(1, 64),
-						stringvalidator.RegexMatches(
-							regexp.MustCompile(`^([*]|[a-zA-Z0-9-_:]+(:[*])?)$`),
-							"must contain only alphanumeric characters and colons used as namespace separators",
-						),
-					),
-				},
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute {
-						"allow": schema.BoolAttribute {
-							Description:	"Does this permission grant the user the ability to execute the action?",
-							Optional: 		true,
-							Computed:		true,
-							PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
-						},
-						"grant": schema.BoolAttribute {
-							Description:	"Allows the user to give the permission to others without being able to execute the action.",
-							Optional:   	true,
-							Computed:		true,
-							PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
-						},
-						"delegate": schema.BoolAttribute {
-							Description: 	"Allows delegating or granting the permission to others without being able to execute the action.",
-							Optional:    	true,
-							Computed:		true,
-							PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
-						},
-					},
-				},
+				Validators: permissionsMapValidators(),
+				NestedObject: permissionsNestedAttributeObject(),
+			},
+		},
+	}
+}
+
+// permissionsMapValidators validates the actions used as keys of a permissions map.
+func permissionsMapValidators() ([]validator.Map) {
+	return []validator.Map{
+		mapvalidator.KeysAre(
+			stringvalidator.LengthBetween(1, 64),
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([*]|[a-zA-Z0-9-_:]+(:[*])?)$`),
+				"must contain only alphanumeric characters and colons used as namespace separators",
+			),
+		),
+		lowercaseActions(),
+	}
+}
+
+// permissionsNestedAttributeObject is the allow, grant and delegate configuration of each action in a permissions map.
+func permissionsNestedAttributeObject() (schema.NestedAttributeObject) {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute {
+			"allow": schema.BoolAttribute {
+				Description:	"Does this permission grant the user the ability to execute the action?",
+				Optional: 		true,
+				Computed:		true,
+				PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
+			},
+			"grant": schema.BoolAttribute {
+				Description:	"Allows the user to give the permission to others without being able to execute the action.",
+				Optional:   	true,
+				Computed:		true,
+				PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
+			},
+			"delegate": schema.BoolAttribute {
+				Description: 	"Allows delegating or granting the permission to others without being able to execute the action.",
+				Optional:    	true,
+				Computed:		true,
+				PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
 			},
 		},
 	}
@@ -149,7 +169,9 @@ func (r *RoleInterfaceProvider) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	r.client = req.ProviderData.(*AuthressSdk.Client)
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -164,17 +186,25 @@ func (r *RoleInterfaceProvider) Create(ctx context.Context, req resource.CreateR
 
 	// Create new role
 	authressSdkRole := MapTerraformRoleToSdk(&plannedAuthressRoleResource)
-	returnedRole, err := r.client.CreateRole(authressSdkRole)
+	returnedRole, err := r.client.CreateRole(ctx, authressSdkRole)
+	if apiError, ok := AuthressSdk.AsAPIError(err); ok && apiError.StatusCode == http.StatusConflict {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_id"),
+			"Authress API Response: Attempted to create role:",
+			GetErrorWrapper("A role with the role_id " + plannedAuthressRoleResource.RoleID.ValueString() + " already exists in Authress. Either import it into your state file with `terraform import` or choose a different role_id."),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Authress API Response: Attempted to create role:",
-			GetErrorWrapper("Could not create role, unexpected error: " + err.Error()),
+			GetCreateApiErrorWrapper("Could not create role", err),
 		)
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plannedAuthressRoleResource = MapSdkRoleToTerraform(returnedRole)
+	plannedAuthressRoleResource = MapSdkRoleToTerraformWithActionCasing(returnedRole, plannedAuthressRoleResource.Permissions)
 	plannedAuthressRoleResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -196,25 +226,29 @@ func (r *RoleInterfaceProvider) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get refreshed role value from Authress
-	authressSdkRole, err := r.client.GetRole(currentAuthressRoleResource.RoleID.ValueString())
+	authressSdkRole, err := r.client.GetRole(ctx, currentAuthressRoleResource.RoleID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Authress API Response: Attempted to get role:",
-			GetErrorWrapper("Could not read Authress role ID " + currentAuthressRoleResource.RoleID.ValueString() + ": " + err.Error()),
+			GetApiErrorWrapper("Could not read Authress role ID " + currentAuthressRoleResource.RoleID.ValueString(), err),
 		)
 		return
 	}
 
 	if authressSdkRole == nil {
-		resp.Diagnostics.AddError(
-			"Authress Role exists in the Terraform plan but does not exist in Authress:",
-			GetErrorWrapper("Either recreate the role in the Authress Management Portal or remove it from your state file. Role ID:" + currentAuthressRoleResource.RoleID.ValueString()),
-		)
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Role exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the role in the Authress Management Portal or remove it from your state file. Role ID:" + currentAuthressRoleResource.RoleID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Role", currentAuthressRoleResource.RoleID.ValueString())
 		return
 	}
 
 	// Set refreshed currentAuthressRoleResource
-	currentAuthressRoleResource = MapSdkRoleToTerraform(authressSdkRole)
+	currentAuthressRoleResource = MapSdkRoleToTerraformWithActionCasing(authressSdkRole, currentAuthressRoleResource.Permissions)
 	diags = resp.State.Set(ctx, &currentAuthressRoleResource)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -232,20 +266,42 @@ func (r *RoleInterfaceProvider) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// The version is only known in the current state, it is sent to ensure the role was not changed since it was last read
+	var currentAuthressRoleResource AuthressRoleResource
+	diags = req.State.Get(ctx, &currentAuthressRoleResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plannedAuthressRoleResource
 	authressSdkRole := MapTerraformRoleToSdk(&plannedAuthressRoleResource)
+	authressSdkRole.Version = currentAuthressRoleResource.Version.ValueString()
+	if authressSdkRole.Version == "" {
+		resp.Diagnostics.AddWarning(
+			"Authress Role changes outside of Terraform are not detected:",
+			"Authress did not return a version for the role " + plannedAuthressRoleResource.RoleID.ValueString() + ", the update is applied without checking whether the role was changed outside of Terraform since it was last read.",
+		)
+	}
 
 	// Update existing role
-	returnedRole, err := r.client.UpdateRole(plannedAuthressRoleResource.RoleID.ValueString(), authressSdkRole)
+	returnedRole, err := r.client.UpdateRole(ctx, plannedAuthressRoleResource.RoleID.ValueString(), authressSdkRole)
+	if apiError, ok := AuthressSdk.AsAPIError(err); ok && apiError.StatusCode == http.StatusPreconditionFailed {
+		resp.Diagnostics.AddError(
+			"Authress Role changed outside of Terraform:",
+			GetErrorWrapper("The role " + plannedAuthressRoleResource.RoleID.ValueString() + " was changed in Authress after Terraform last read it, the update was not applied to avoid overwriting those changes. Re-run terraform plan to review the changes before applying again."),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Authress API Response: Attempted to update role:",
-			GetErrorWrapper("Could not update role, unexpected error: " + err.Error()),
+			GetApiErrorWrapper("Could not update role", err),
 		)
 		return
 	}
 
-	plannedAuthressRoleResource = MapSdkRoleToTerraform(returnedRole)
+	plannedAuthressRoleResource = MapSdkRoleToTerraformWithActionCasing(returnedRole, plannedAuthressRoleResource.Permissions)
 	plannedAuthressRoleResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plannedAuthressRoleResource)
@@ -266,11 +322,18 @@ func (r *RoleInterfaceProvider) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Delete existing role
-	err := r.client.DeleteRole(currentAuthressRoleResource.RoleID.ValueString())
+	err := r.client.DeleteRole(ctx, currentAuthressRoleResource.RoleID.ValueString())
+	if apiError, ok := AuthressSdk.AsAPIError(err); ok && apiError.StatusCode == http.StatusConflict {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete role:",
+			GetErrorWrapper("The role " + currentAuthressRoleResource.RoleID.ValueString() + " is still in use, for example by access records, and cannot be deleted. Remove the role from the access records that reference it before deleting it."),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Authress API Response: Attempted to delete role:",
-			GetErrorWrapper("Could not delete role, unexpected error: " + err.Error()),
+			GetApiErrorWrapper("Could not delete role", err),
 		)
 		return
 	}
@@ -281,45 +344,136 @@ func (r *RoleInterfaceProvider) ImportState(ctx context.Context, req resource.Im
 	resource.ImportStatePassthroughID(ctx, path.Root("role_id"), req, resp)
 }
 
+// ModifyPlan treats permission actions that only differ by case as equal.
+func (r *RoleInterfaceProvider) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ModifyPlanPermissionActionCasing(ctx, req, resp)
+}
+
 func MapSdkRoleToTerraform(authressSdkRole *AuthressSdk.Role) (AuthressRoleResource) {
 	terraformRole := AuthressRoleResource {
 		RoleID: TerraformType.StringValue(authressSdkRole.RoleID),
 		LegacyID: TerraformType.StringValue(authressSdkRole.RoleID),
 		Name: TerraformType.StringValue(authressSdkRole.Name),
 		Description: TerraformType.StringValue(authressSdkRole.Description),
-		Permissions: make(map[string]AuthressRolePermissionResource),
+		Version: MapSdkOptionalStringToTerraform(authressSdkRole.Version),
+		Permissions: MapSdkPermissionsToTerraform(authressSdkRole.Permissions),
 	}
 
-	for _, authressRolePermission := range authressSdkRole.Permissions {
-		terraformRole.Permissions[authressRolePermission.Action] = AuthressRolePermissionResource {
-			Allow: TerraformType.BoolValue(authressRolePermission.Allow),
-			Grant: TerraformType.BoolValue(authressRolePermission.Grant),
-			Delegate: TerraformType.BoolValue(authressRolePermission.Delegate),
-		}
-   }
-
    return terraformRole
 }
 
+// MapSdkRoleToTerraformWithActionCasing keeps the casing of the actions that are already in the plan or state.
+func MapSdkRoleToTerraformWithActionCasing(authressSdkRole *AuthressSdk.Role, existingPermissions map[string]AuthressRolePermissionResource) (AuthressRoleResource) {
+	terraformRole := MapSdkRoleToTerraform(authressSdkRole)
+	terraformRole.Permissions = PreservePermissionActionCasing(terraformRole.Permissions, existingPermissions)
+	return terraformRole
+}
+
 func MapTerraformRoleToSdk(terraformRole *AuthressRoleResource) (AuthressSdk.Role) {
 	authressSdkRole := AuthressSdk.Role {
 		RoleID: terraformRole.RoleID.ValueString(),
 		Name: terraformRole.Name.ValueString(),
 		Description: terraformRole.Description.ValueString(),
-		Permissions: make([]AuthressSdk.Permission, 0, len(terraformRole.Permissions)),
+		Permissions: MapTerraformPermissionsToSdk(terraformRole.Permissions),
+	}
+
+   return authressSdkRole
+}
+
+func MapSdkPermissionsToTerraform(authressSdkPermissions []AuthressSdk.Permission) (map[string]AuthressRolePermissionResource) {
+	terraformPermissions := make(map[string]AuthressRolePermissionResource)
+	for _, authressRolePermission := range authressSdkPermissions {
+		terraformPermissions[authressRolePermission.Action] = AuthressRolePermissionResource {
+			Allow: TerraformType.BoolValue(authressRolePermission.Allow),
+			Grant: TerraformType.BoolValue(authressRolePermission.Grant),
+			Delegate: TerraformType.BoolValue(authressRolePermission.Delegate),
+		}
+	}
+
+	return terraformPermissions
+}
+
+// PreservePermissionActionCasing uses the action keys of the existing permissions when they only differ by case.
+// Actions are case-insensitive and Authress returns them lowercased, which would otherwise show a diff for every action that is not lowercase in the configuration.
+// The existing actions never only differ by case, the permissions validators reject those, so there is always a single matching existing action.
+// After an import there are no existing actions, ModifyPlanPermissionActionCasing then keeps the lowercase actions of the state.
+func PreservePermissionActionCasing(terraformPermissions map[string]AuthressRolePermissionResource, existingPermissions map[string]AuthressRolePermissionResource) (map[string]AuthressRolePermissionResource) {
+	existingActions := make(map[string]string, len(existingPermissions))
+	for existingAction := range existingPermissions {
+		existingActions[strings.ToLower(existingAction)] = existingAction
+	}
+
+	casedPermissions := make(map[string]AuthressRolePermissionResource, len(terraformPermissions))
+	for action, value := range terraformPermissions {
+		if existingAction, exists := existingActions[strings.ToLower(action)]; exists {
+			action = existingAction
+		}
+		casedPermissions[action] = value
+	}
+
+	return casedPermissions
+}
+
+// ModifyPlanPermissionActionCasing plans the permissions of the state when the planned permissions only differ by the casing of the actions.
+// Terraform accepts the prior state instead of the configuration as planned value, which avoids planning updates that do not change anything in Authress.
+func ModifyPlanPermissionActionCasing(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create and delete
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedPermissions, currentPermissions TerraformType.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &plannedPermissions)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("permissions"), &currentPermissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plannedPermissions.IsUnknown() || plannedPermissions.IsNull() || currentPermissions.IsNull() || plannedPermissions.Equal(currentPermissions) {
+		return
+	}
+	if !permissionsEqualIgnoringActionCase(plannedPermissions.Elements(), currentPermissions.Elements()) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), currentPermissions)...)
+}
+
+func permissionsEqualIgnoringActionCase(plannedPermissions map[string]attr.Value, currentPermissions map[string]attr.Value) (bool) {
+	if len(plannedPermissions) != len(currentPermissions) {
+		return false
 	}
-	for key, value := range terraformRole.Permissions {
+
+	lowercaseCurrentPermissions := make(map[string]attr.Value, len(currentPermissions))
+	for action, value := range currentPermissions {
+		lowercaseCurrentPermissions[strings.ToLower(action)] = value
+	}
+
+	for action, value := range plannedPermissions {
+		currentValue, exists := lowercaseCurrentPermissions[strings.ToLower(action)]
+		if !exists || !value.Equal(currentValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func MapTerraformPermissionsToSdk(terraformPermissions map[string]AuthressRolePermissionResource) ([]AuthressSdk.Permission) {
+	authressSdkPermissions := make([]AuthressSdk.Permission, 0, len(terraformPermissions))
+	for key, value := range terraformPermissions {
 		authressSdkRolePermissions := AuthressSdk.Permission {
 			Action: key,
 			Allow: value.Allow.ValueBool(),
 			Grant: value.Grant.ValueBool(),
 			Delegate: value.Delegate.ValueBool(),
 		}
-		authressSdkRole.Permissions = append(authressSdkRole.Permissions, authressSdkRolePermissions)
+		authressSdkPermissions = append(authressSdkPermissions, authressSdkRolePermissions)
 	}
 
-   return authressSdkRole
+	return authressSdkPermissions
 }
+
 func GetErrorWrapper(errorString string) (string) {
 	responseString := errorString
 	if (strings.Contains(errorString, "invalid character '<' looking for")) {
@@ -328,4 +482,62 @@ func GetErrorWrapper(errorString string) (string) {
 	return "\n************************************************************\nError Details:\n\n" +
 	responseString +
 	"\n************************************************************\n\n"
-}
\ No newline at end of file
+}
+
+// GetApiErrorWrapper explains the Authress API error so that it can be acted on, falls back to the raw error for everything else.
+func GetApiErrorWrapper(message string, err error) (string) {
+	return getApiErrorWrapper(message, err, "The request conflicts with the current state of the resource in Authress, for example the resource is still in use by other resources or it was changed at the same time. Review the resource in the Authress Management Portal and try again.")
+}
+
+// GetCreateApiErrorWrapper explains the Authress API error of a create request, where a conflict means the resource already exists.
+// Only use it for resources with an identifier chosen in the configuration that can be imported.
+func GetCreateApiErrorWrapper(message string, err error) (string) {
+	return getApiErrorWrapper(message, err, "The resource already exists in Authress. Import it into your state file with `terraform import` or choose a different identifier.")
+}
+
+func getApiErrorWrapper(message string, err error, conflictExplanation string) (string) {
+	apiError, ok := AuthressSdk.AsAPIError(err)
+	if !ok {
+		return GetErrorWrapper(message + ": " + err.Error())
+	}
+
+	var explanation string
+	switch {
+	case apiError.StatusCode == http.StatusUnauthorized:
+		explanation = "The Authress access key is not valid. Verify the access_key in the Authress provider configuration or the AUTHRESS_KEY environment variable, and that the key has not been revoked."
+	case apiError.StatusCode == http.StatusForbidden:
+		explanation = "The Authress access key does not have permission to perform this action. Grant the service client of the access key the required permissions in the Authress Management Portal."
+	case apiError.StatusCode == http.StatusConflict:
+		explanation = conflictExplanation
+	case (apiError.StatusCode == http.StatusBadRequest || apiError.StatusCode == http.StatusUnprocessableEntity) && apiError.Field != "":
+		explanation = "The property " + apiError.Field + " is not valid: " + getApiErrorDescription(apiError)
+	case apiError.StatusCode == http.StatusBadRequest || apiError.StatusCode == http.StatusUnprocessableEntity:
+		explanation = "The request is not valid: " + getApiErrorDescription(apiError)
+	default:
+		explanation = apiError.Error()
+	}
+
+	if apiError.RequestID != "" {
+		explanation += "\n\nRequest ID: " + apiError.RequestID
+	}
+	return GetErrorWrapper(message + ": " + explanation)
+}
+
+func getApiErrorDescription(apiError *AuthressSdk.APIError) (string) {
+	if apiError.Detail != "" {
+		return apiError.Detail
+	}
+	if apiError.Title != "" {
+		return apiError.Title
+	}
+	return apiError.Body
+}
+
+// RemoveDeletedResource removes a resource that was deleted outside of Terraform from the state, so that Terraform plans to recreate it.
+func RemoveDeletedResource(ctx context.Context, resp *resource.ReadResponse, resourceName string, resourceID string) {
+	resp.Diagnostics.AddWarning(
+		"Authress " + resourceName + " no longer exists in Authress:",
+		"The " + strings.ToLower(resourceName) + " " + resourceID + " was deleted outside of Terraform and has been removed from the state, Terraform will plan to recreate it. Set strict_read in the Authress provider configuration to fail instead.",
+	)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,150 @@
+package authress
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &RoleDataSourceInterfaceProvider{}
+	_ datasource.DataSourceWithConfigure = &RoleDataSourceInterfaceProvider{}
+)
+
+// NewRoleDataSource is a helper function to simplify the provider implementation.
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSourceInterfaceProvider{}
+}
+
+// RoleDataSourceInterfaceProvider is the data source implementation.
+type RoleDataSourceInterfaceProvider struct {
+	client *AuthressSdk.Client
+}
+
+/*******************************************/
+/* Data stored in Terraform State          */
+/*******************************************/
+type AuthressRoleDataSource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String						`tfsdk:"id"`
+	RoleID		TerraformType.String						`tfsdk:"role_id"`
+	Name 		TerraformType.String						`tfsdk:"name"`
+	Description TerraformType.String						`tfsdk:"description"`
+	Permissions map[string]AuthressRolePermissionResource	`tfsdk:"permissions"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (d *RoleDataSourceInterfaceProvider) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the data source.
+func (d *RoleDataSourceInterfaceProvider) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Authress `Role` by its role ID, to reference roles that are managed outside of this Terraform workspace. See Authress KB for more information.",
+		MarkdownDescription: "Looks up an existing Authress `Role` by its role ID, to reference roles that are managed outside of this Terraform workspace. See [Roles and Permissions](https://authress.io/knowledge-base/docs/authorization/permissions#roles) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"role_id": schema.StringAttribute {
+				Description:	"Unique identifier of the role to look up.",
+				Required:		true,
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+			},
+			"name": schema.StringAttribute {
+				Description:	"The name of the role.",
+				Computed:   	true,
+			},
+			"description": schema.StringAttribute {
+				Description:	"The description of the role.",
+				Computed:		true,
+			},
+			"permissions": schema.MapNestedAttribute {
+				Description:	"A map of the permissions of the role. The key of the map is the action the permission grants.",
+				Computed:		true,
+				NestedObject:	permissionsDataSourceNestedAttributeObject(),
+			},
+		},
+	}
+}
+
+// permissionsDataSourceNestedAttributeObject defines the permission configuration returned by data sources.
+func permissionsDataSourceNestedAttributeObject() (schema.NestedAttributeObject) {
+	return schema.NestedAttributeObject {
+		Attributes: map[string]schema.Attribute {
+			"allow": schema.BoolAttribute {
+				Description:	"Does this permission grant the user the ability to execute the action?",
+				Computed:		true,
+			},
+			"grant": schema.BoolAttribute {
+				Description:	"Allows the user to give the permission to others without being able to execute the action.",
+				Computed:		true,
+			},
+			"delegate": schema.BoolAttribute {
+				Description:	"Allows delegating or granting the permission to others without being able to execute the action.",
+				Computed:		true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RoleDataSourceInterfaceProvider) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*AuthressProviderData).Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RoleDataSourceInterfaceProvider) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the configured role ID
+	var configuredAuthressRoleDataSource AuthressRoleDataSource
+	diags := req.Config.Get(ctx, &configuredAuthressRoleDataSource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get role value from Authress
+	authressSdkRole, err := d.client.GetRole(ctx, configuredAuthressRoleDataSource.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get role:",
+			GetApiErrorWrapper("Could not read Authress role ID " + configuredAuthressRoleDataSource.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkRole == nil {
+		resp.Diagnostics.AddError(
+			"Authress Role does not exist in Authress:",
+			GetErrorWrapper("Verify the role ID in the Authress Management Portal. Role ID:" + configuredAuthressRoleDataSource.RoleID.ValueString()),
+		)
+		return
+	}
+
+	// Set state to the role returned by Authress
+	terraformRole := MapSdkRoleToTerraform(authressSdkRole)
+	currentAuthressRoleDataSource := AuthressRoleDataSource {
+		LegacyID: terraformRole.LegacyID,
+		RoleID: terraformRole.RoleID,
+		Name: terraformRole.Name,
+		Description: terraformRole.Description,
+		Permissions: terraformRole.Permissions,
+	}
+	diags = resp.State.Set(ctx, &currentAuthressRoleDataSource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
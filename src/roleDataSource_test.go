@@ -0,0 +1,42 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "authress_role" "test-4" {
+	role_id = "ro_test-4"
+	name = "Terraform Test Role 4"
+	description = "Role read by the data source"
+	permissions = {
+		"four" = {
+			"allow" = true
+			"grant" = true
+		}
+	}
+}
+
+data "authress_role" "test-4" {
+	role_id = authress_role.test-4.role_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.authress_role.test-4", "role_id", "ro_test-4"),
+					resource.TestCheckResourceAttr("data.authress_role.test-4", "name", "Terraform Test Role 4"),
+					resource.TestCheckResourceAttr("data.authress_role.test-4", "description", "Role read by the data source"),
+					resource.TestCheckResourceAttr("data.authress_role.test-4", "permissions.four.allow", "true"),
+					resource.TestCheckResourceAttr("data.authress_role.test-4", "permissions.four.grant", "true"),
+					resource.TestCheckResourceAttr("data.authress_role.test-4", "permissions.four.delegate", "false"),
+				),
+			},
+		},
+	})
+}
@@ -1,9 +1,17 @@
 package authress
 
 import (
+	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestRoleResource(t *testing.T) {
@@ -14,7 +22,7 @@ func TestRoleResource(t *testing.T) {
 			{
 				Config: providerConfig + `
 resource "authress_role" "test-100" {
-	role_id = "lo_test-1"
+	role_id = "ro_test-1"
 	name = "Terraform Test Role"
 	permissions = {
 		"one" = {
@@ -58,3 +66,184 @@ resource "authress_role" "test-2" {
 		},
 	})
 }
+
+func TestRoleResourceDeletedOutsideOfTerraform(t *testing.T) {
+	if testServer == nil {
+		t.Skip("Deleting the role outside of Terraform requires the in memory Authress API")
+	}
+
+	config := providerConfig + `
+resource "authress_role" "test-3" {
+	role_id = "ro_test-3"
+	name = "Terraform Test Role 3"
+	permissions = {
+		"three" = {
+			"allow" = true
+		}
+	}
+}`
+
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// The deleted role is removed from the state and recreated
+			{
+				PreConfig: func() { testServer.Remove("roles", "ro_test-3") },
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_role.test-3", "role_id", "ro_test-3"),
+				),
+			},
+		},
+	})
+}
+
+func TestRoleResourceMixedCaseActions(t *testing.T) {
+	config := func(action string) (string) {
+		return providerConfig + fmt.Sprintf(`
+resource "authress_role" "test-7" {
+	role_id = "ro_test-7"
+	name = "Terraform Test Role 7"
+	permissions = {
+		"%s" = {
+			"allow" = true
+		}
+	}
+}`, action)
+	}
+
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Authress returns the action lowercase, the configured casing is kept so that there is no diff
+			{
+				Config: config("Documents:Read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_role.test-7", "permissions.Documents:Read.allow", "true"),
+				),
+			},
+			{
+				Config: config("Documents:Read"),
+				PlanOnly: true,
+			},
+			// Changing only the casing of the action is not a change
+			{
+				Config: config("documents:read"),
+				PlanOnly: true,
+			},
+			// Without a previous state Authress only returns the lowercase action, which is planned as equal to the mixed case configuration
+			{
+				ResourceName:      "authress_role.test-7",
+				ImportState:       true,
+				ImportStatePersist: true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"last_updated", "permissions.Documents:Read", "permissions.documents:read"},
+				ImportStateCheck: func(states []*terraform.InstanceState) (error) {
+					if len(states) != 1 || states[0].Attributes["permissions.documents:read.allow"] != "true" {
+						return fmt.Errorf("expected the imported role to contain the lowercase action, got %v", states)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config("Documents:Read"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestPermissionsEqualIgnoringActionCase(t *testing.T) {
+	permissionType := map[string]attr.Type{ "allow": TerraformType.BoolType }
+	allowed := TerraformType.ObjectValueMust(permissionType, map[string]attr.Value{ "allow": TerraformType.BoolValue(true) })
+	denied := TerraformType.ObjectValueMust(permissionType, map[string]attr.Value{ "allow": TerraformType.BoolValue(false) })
+
+	testCases := []struct {
+		planned		map[string]attr.Value
+		current		map[string]attr.Value
+		expected	bool
+	}{
+		{ map[string]attr.Value{ "Documents:Read": allowed }, map[string]attr.Value{ "documents:read": allowed }, true },
+		{ map[string]attr.Value{ "documents:read": allowed }, map[string]attr.Value{ "Documents:Read": allowed }, true },
+		{ map[string]attr.Value{ "Documents:Read": denied }, map[string]attr.Value{ "documents:read": allowed }, false },
+		{ map[string]attr.Value{ "Documents:Write": allowed }, map[string]attr.Value{ "documents:read": allowed }, false },
+		{ map[string]attr.Value{ "Documents:Read": allowed, "documents:write": allowed }, map[string]attr.Value{ "documents:read": allowed }, false },
+	}
+
+	for index, testCase := range testCases {
+		if actual := permissionsEqualIgnoringActionCase(testCase.planned, testCase.current); actual != testCase.expected {
+			t.Errorf("test case %d: expected %v, got %v", index, testCase.expected, actual)
+		}
+	}
+}
+
+func TestRoleResourceDuplicateActions(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "authress_role" "test-8" {
+	role_id = "ro_test-8"
+	name = "Terraform Test Role 8"
+	permissions = {
+		"Documents:Read" = {
+			"allow" = true
+		}
+		"documents:read" = {
+			"allow" = false
+		}
+	}
+}`,
+				ExpectError: regexp.MustCompile("Duplicate permission action"),
+			},
+		},
+	})
+}
+
+func TestPreservePermissionActionCasing(t *testing.T) {
+	permission := AuthressRolePermissionResource{ Allow: TerraformType.BoolValue(true), Grant: TerraformType.BoolValue(false), Delegate: TerraformType.BoolValue(false) }
+	returnedPermissions := map[string]AuthressRolePermissionResource{ "documents:read": permission, "documents:write": permission }
+	existingPermissions := map[string]AuthressRolePermissionResource{ "Documents:Read": permission }
+
+	permissions := PreservePermissionActionCasing(returnedPermissions, existingPermissions)
+	if _, ok := permissions["Documents:Read"]; !ok || len(permissions) != 2 {
+		t.Fatalf("expected the existing action casing to be kept, got %v", permissions)
+	}
+	if _, ok := permissions["documents:write"]; !ok {
+		t.Fatalf("expected new actions to be returned as is, got %v", permissions)
+	}
+}
+
+func TestLowercaseActionsValidator(t *testing.T) {
+	permissionType := TerraformType.ObjectType{ AttrTypes: map[string]attr.Type{ "allow": TerraformType.BoolType } }
+	permission := TerraformType.ObjectValueMust(permissionType.AttrTypes, map[string]attr.Value{ "allow": TerraformType.BoolValue(true) })
+	request := validator.MapRequest{
+		Path: path.Root("permissions"),
+		ConfigValue: TerraformType.MapValueMust(permissionType, map[string]attr.Value{ "documents:read": permission, "Documents:Write": permission }),
+	}
+
+	response := validator.MapResponse{}
+	lowercaseActions().ValidateMap(context.Background(), request, &response)
+	if response.Diagnostics.HasError() || response.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning for the mixed case action, got %v", response.Diagnostics)
+	}
+}
+
+func TestLowercaseActionsValidatorDuplicateActions(t *testing.T) {
+	permissionType := TerraformType.ObjectType{ AttrTypes: map[string]attr.Type{ "allow": TerraformType.BoolType } }
+	permission := TerraformType.ObjectValueMust(permissionType.AttrTypes, map[string]attr.Value{ "allow": TerraformType.BoolValue(true) })
+	request := validator.MapRequest{
+		Path: path.Root("permissions"),
+		ConfigValue: TerraformType.MapValueMust(permissionType, map[string]attr.Value{ "documents:read": permission, "Documents:Read": permission }),
+	}
+
+	response := validator.MapResponse{}
+	lowercaseActions().ValidateMap(context.Background(), request, &response)
+	if response.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected an error for the actions that only differ by case, got %v", response.Diagnostics)
+	}
+}
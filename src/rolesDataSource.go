@@ -0,0 +1,209 @@
+package authress
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &RolesDataSourceInterfaceProvider{}
+	_ datasource.DataSourceWithConfigure = &RolesDataSourceInterfaceProvider{}
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSourceInterfaceProvider{}
+}
+
+// RolesDataSourceInterfaceProvider is the data source implementation.
+type RolesDataSourceInterfaceProvider struct {
+	client *AuthressSdk.Client
+}
+
+/*******************************************/
+/* Data stored in Terraform State          */
+/*******************************************/
+type AuthressRolesDataSource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID		TerraformType.String			`tfsdk:"id"`
+	RoleIDPrefix	TerraformType.String			`tfsdk:"role_id_prefix"`
+	NameRegex		TerraformType.String			`tfsdk:"name_regex"`
+	Action			TerraformType.String			`tfsdk:"action"`
+	Roles			[]AuthressRolesDataSourceRole	`tfsdk:"roles"`
+}
+
+type AuthressRolesDataSourceRole struct {
+	RoleID		TerraformType.String						`tfsdk:"role_id"`
+	Name 		TerraformType.String						`tfsdk:"name"`
+	Description TerraformType.String						`tfsdk:"description"`
+	Permissions map[string]AuthressRolePermissionResource	`tfsdk:"permissions"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (d *RolesDataSourceInterfaceProvider) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *RolesDataSourceInterfaceProvider) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Authress `Roles` in the account, optionally filtered, to audit and reference roles in bulk. See Authress KB for more information.",
+		MarkdownDescription: "Lists the Authress `Roles` in the account, optionally filtered, to audit and reference roles in bulk. See [Roles and Permissions](https://authress.io/knowledge-base/docs/authorization/permissions#roles) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"role_id_prefix": schema.StringAttribute {
+				Description:	"Only return roles whose role ID starts with this prefix.",
+				Optional:		true,
+			},
+			"name_regex": schema.StringAttribute {
+				Description:	"Only return roles whose name matches this regular expression.",
+				Optional:		true,
+			},
+			"action": schema.StringAttribute {
+				Description:	"Only return roles that allow this action, including wildcard and parent permissions such as `documents:*` or `documents` that cover `documents:delete`. Permissions that do not allow the action are ignored. The action is case-insensitive.",
+				Optional:		true,
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+			},
+			"roles": schema.ListNestedAttribute {
+				Description:	"The roles that match all of the configured filters.",
+				Computed:		true,
+				NestedObject:	schema.NestedAttributeObject {
+					Attributes: map[string]schema.Attribute {
+						"role_id": schema.StringAttribute {
+							Description:	"Unique identifier of the role.",
+							Computed:		true,
+						},
+						"name": schema.StringAttribute {
+							Description:	"The name of the role.",
+							Computed:   	true,
+						},
+						"description": schema.StringAttribute {
+							Description:	"The description of the role.",
+							Computed:		true,
+						},
+						"permissions": schema.MapNestedAttribute {
+							Description:	"A map of the permissions of the role. The key of the map is the action the permission grants.",
+							Computed:		true,
+							NestedObject:	permissionsDataSourceNestedAttributeObject(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RolesDataSourceInterfaceProvider) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*AuthressProviderData).Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RolesDataSourceInterfaceProvider) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the configured filters
+	var configuredAuthressRolesDataSource AuthressRolesDataSource
+	diags := req.Config.Get(ctx, &configuredAuthressRolesDataSource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if configuredAuthressRolesDataSource.NameRegex.ValueString() != "" {
+		compiledNameRegex, err := regexp.Compile(configuredAuthressRolesDataSource.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid name_regex:",
+				GetErrorWrapper("The name_regex is not a valid regular expression: " + err.Error()),
+			)
+			return
+		}
+		nameRegex = compiledNameRegex
+	}
+
+	// Get the roles from Authress
+	authressSdkRoles, err := d.client.GetRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to list roles:",
+			GetApiErrorWrapper("Could not list Authress roles", err),
+		)
+		return
+	}
+
+	// Set state to the roles that match every filter
+	roleIDPrefix := configuredAuthressRolesDataSource.RoleIDPrefix.ValueString()
+	action := configuredAuthressRolesDataSource.Action.ValueString()
+	currentAuthressRolesDataSource := AuthressRolesDataSource {
+		LegacyID: TerraformType.StringValue("roles"),
+		RoleIDPrefix: configuredAuthressRolesDataSource.RoleIDPrefix,
+		NameRegex: configuredAuthressRolesDataSource.NameRegex,
+		Action: configuredAuthressRolesDataSource.Action,
+		Roles: []AuthressRolesDataSourceRole{},
+	}
+	for index := range authressSdkRoles {
+		authressSdkRole := &authressSdkRoles[index]
+		if !strings.HasPrefix(authressSdkRole.RoleID, roleIDPrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(authressSdkRole.Name) {
+			continue
+		}
+		if action != "" && !roleContainsAction(authressSdkRole, action) {
+			continue
+		}
+
+		terraformRole := MapSdkRoleToTerraform(authressSdkRole)
+		currentAuthressRolesDataSource.Roles = append(currentAuthressRolesDataSource.Roles, AuthressRolesDataSourceRole {
+			RoleID: terraformRole.RoleID,
+			Name: terraformRole.Name,
+			Description: terraformRole.Description,
+			Permissions: terraformRole.Permissions,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &currentAuthressRolesDataSource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// roleContainsAction checks the allowed role permissions the way Authress matches actions, case-insensitive, with the `*` wildcard and parent actions, `action:*` or `action` implies `action:sub-action`.
+func roleContainsAction(authressSdkRole *AuthressSdk.Role, action string) (bool) {
+	action = strings.ToLower(action)
+	for _, authressRolePermission := range authressSdkRole.Permissions {
+		if !authressRolePermission.Allow {
+			continue
+		}
+
+		permissionAction := strings.ToLower(authressRolePermission.Action)
+		if permissionAction == action || permissionAction == "*" {
+			return true
+		}
+		parentAction := strings.TrimSuffix(permissionAction, ":*")
+		if strings.HasPrefix(action, parentAction + ":") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+func TestRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "authress_role" "test-5" {
+	role_id = "ro_test-5-documents"
+	name = "Terraform Test Documents Deleter"
+	permissions = {
+		"documents:delete" = {
+			"allow" = true
+		}
+	}
+}
+
+resource "authress_role" "test-6" {
+	role_id = "ro_test-5-reader"
+	name = "Terraform Test Documents Reader"
+	permissions = {
+		"documents:read" = {
+			"allow" = true
+		}
+		"documents:delete" = {
+			"allow" = false
+			"grant" = true
+		}
+	}
+}
+
+data "authress_roles" "test-5" {
+	role_id_prefix = "ro_test-5"
+	name_regex = "^Terraform Test Documents"
+	action = "Documents:Delete"
+
+	depends_on = [authress_role.test-5, authress_role.test-6]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.authress_roles.test-5", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.authress_roles.test-5", "roles.0.role_id", "ro_test-5-documents"),
+					resource.TestCheckResourceAttr("data.authress_roles.test-5", "roles.0.permissions.documents:delete.allow", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestRoleContainsAction(t *testing.T) {
+	testCases := []struct {
+		permissionAction	string
+		allow				bool
+		action				string
+		expected			bool
+	}{
+		{ "documents:delete", true, "documents:delete", true },
+		{ "Documents:Delete", true, "documents:delete", true },
+		{ "documents:*", true, "documents:delete", true },
+		{ "documents", true, "documents:delete", true },
+		{ "documents", true, "documents:delete:all", true },
+		{ "*", true, "documents:delete", true },
+		{ "documents:delete", false, "documents:delete", false },
+		{ "documents:*", false, "documents:delete", false },
+		{ "documents:read", true, "documents:delete", false },
+		{ "documents:delete", true, "documents", false },
+		{ "docs:*", true, "documents:delete", false },
+		{ "documents", true, "documentsarchive:delete", false },
+	}
+
+	for _, testCase := range testCases {
+		role := &AuthressSdk.Role{ Permissions: []AuthressSdk.Permission{ { Action: testCase.permissionAction, Allow: testCase.allow } } }
+		if actual := roleContainsAction(role, testCase.action); actual != testCase.expected {
+			t.Errorf("roleContainsAction(%q allow=%v, %q) = %v, expected %v", testCase.permissionAction, testCase.allow, testCase.action, actual, testCase.expected)
+		}
+	}
+}
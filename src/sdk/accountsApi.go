@@ -0,0 +1,84 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetAccountIdentities(ctx context.Context) ([]AccountIdentity, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/identities", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	identityCollection := AccountIdentityCollection{}
+	err = json.Unmarshal(body, &identityCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	return identityCollection.Identities, nil
+}
+
+func (c *Client) GetAccountIdentity(ctx context.Context, identityID string) (*AccountIdentity, error) {
+	identities, err := c.GetAccountIdentities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identity := range identities {
+		if identity.IdentityID == identityID {
+			return &identity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) CreateAccountIdentity(ctx context.Context, identity AccountIdentity) (*AccountIdentity, error) {
+	rb, err := json.Marshal(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/identities", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newIdentity := AccountIdentity{}
+	err = json.Unmarshal(body, &newIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newIdentity, nil
+}
+
+func (c *Client) DeleteAccountIdentity(ctx context.Context, identityID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/identities/%s", c.HostURL, url.PathEscape(identityID)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,96 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetApplication(ctx context.Context, applicationID string) (*Application, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/applications/%s", c.HostURL, applicationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	application := Application{}
+	err = json.Unmarshal(body, &application)
+	if err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
+
+func (c *Client) CreateApplication(ctx context.Context, application Application) (*Application, error) {
+	rb, err := json.Marshal(application)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/applications", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newApplication := Application{}
+	err = json.Unmarshal(body, &newApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newApplication, nil
+}
+
+func (c *Client) UpdateApplication(ctx context.Context, applicationID string, application Application) (*Application, error) {
+	rb, err := json.Marshal(application)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/applications/%s", c.HostURL, applicationID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newApplication := Application{}
+	err = json.Unmarshal(body, &newApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newApplication, nil
+}
+
+func (c *Client) DeleteApplication(ctx context.Context, applicationID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/applications/%s", c.HostURL, applicationID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,449 @@
+// Package authresstest provides an in memory Authress API for tests, so that the provider can be tested without an Authress account.
+package authresstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Hook - Intercepts every request before it is handled, returning true means the hook already wrote the response
+type Hook func(w http.ResponseWriter, r *http.Request) (bool)
+
+type collectionConfiguration struct {
+	idField		string
+	idPrefix	string
+}
+
+// The collections of the Authress API implemented by the server, keyed by the first path segment after /v1/
+var collections = map[string]collectionConfiguration{
+	"roles": { idField: "roleId", idPrefix: "ro_" },
+	"records": { idField: "recordId", idPrefix: "rec_" },
+	"groups": { idField: "groupId", idPrefix: "grp_" },
+	"users": { idField: "userId", idPrefix: "user_" },
+	"tenants": { idField: "tenantId", idPrefix: "ten_" },
+	"connections": { idField: "connectionId", idPrefix: "con_" },
+	"clients": { idField: "clientId", idPrefix: "sc_" },
+	"applications": { idField: "applicationId", idPrefix: "app_" },
+	"extensions": { idField: "extensionId", idPrefix: "ext_" },
+	"invites": { idField: "inviteId", idPrefix: "inv_" },
+	"identities": { idField: "identityId", idPrefix: "idn_" },
+}
+
+type item struct {
+	data	map[string]any
+	version	int
+}
+
+// Server - In memory Authress API served over httptest
+type Server struct {
+	*httptest.Server
+
+	mutex		sync.Mutex
+	items		map[string]map[string]*item
+	order		map[string][]string
+	resources	map[string]map[string]any
+	hooks		[]Hook
+	latency		time.Duration
+	pageSize	int
+	nextID		int
+}
+
+// NewServer starts an empty in memory Authress API, close it when the test completes.
+func NewServer() (*Server) {
+	s := &Server{
+		items: map[string]map[string]*item{},
+		order: map[string][]string{},
+		resources: map[string]map[string]any{},
+		pageSize: 20,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddHook registers a hook that runs for every request in the order the hooks were added.
+func (s *Server) AddHook(hook Hook) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.hooks = append(s.hooks, hook)
+}
+
+// InjectError responds with the status to the next count requests matching the method and path prefix, a count of 0 fails every request.
+func (s *Server) InjectError(method string, pathPrefix string, status int, count int) {
+	var mutex sync.Mutex
+	remaining := count
+	s.AddHook(func(w http.ResponseWriter, r *http.Request) (bool) {
+		if r.Method != method || !strings.HasPrefix(r.URL.Path, pathPrefix) {
+			return false
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if count > 0 {
+			if remaining == 0 {
+				return false
+			}
+			remaining--
+		}
+
+		writeError(w, status, "InjectedError", fmt.Sprintf("Injected %d response", status))
+		return true
+	})
+}
+
+// SetLatency delays every response, to test timeouts and cancellation.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.latency = latency
+}
+
+// SetPageSize changes the number of items returned per page when the request does not specify a limit, to test pagination.
+func (s *Server) SetPageSize(pageSize int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.pageSize = pageSize
+}
+
+// Get returns a copy of the stored item, to verify the requests sent by a test.
+func (s *Server) Get(collection string, id string) (map[string]any, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	storedItem, ok := s.items[collection][id]
+	if !ok {
+		return nil, false
+	}
+	return copyData(storedItem.data), true
+}
+
+// Set creates or replaces an item, to simulate changes made outside of the test such as in the Authress Management Portal.
+func (s *Server) Set(collection string, data map[string]any) (error) {
+	configuration, ok := collections[collection]
+	if !ok {
+		return fmt.Errorf("the collection %s is not implemented", collection)
+	}
+	id, _ := data[configuration.idField].(string)
+	if id == "" {
+		return fmt.Errorf("the item does not have a %s", configuration.idField)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.store(collection, id, copyData(data))
+	return nil
+}
+
+// Remove deletes an item, to simulate resources deleted outside of the test.
+func (s *Server) Remove(collection string, id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.remove(collection, id)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	hooks := append([]Hook{}, s.hooks...)
+	latency := s.latency
+	s.mutex.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(latency):
+		}
+	}
+
+	for _, hook := range hooks {
+		if hook(w, r) {
+			return
+		}
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") || strings.TrimPrefix(authorization, "Bearer ") == "" {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "The request does not contain a valid bearer token")
+		return
+	}
+
+	pathSegments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/"), "/"), "/")
+	if !strings.HasPrefix(r.URL.Path, "/v1/") || pathSegments[0] == "" {
+		writeError(w, http.StatusNotFound, "NotFound", "The route does not exist")
+		return
+	}
+
+	var body map[string]any
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		requestBody, _ := ioutil.ReadAll(r.Body)
+		if len(requestBody) > 0 {
+			if err := json.Unmarshal(requestBody, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "InvalidRequest", "The request body is not valid JSON")
+				return
+			}
+		}
+		if body == nil {
+			body = map[string]any{}
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if pathSegments[0] == "resources" {
+		s.handleResource(w, r, strings.TrimPrefix(r.URL.Path, "/v1/resources/"), body)
+		return
+	}
+
+	if pathSegments[0] == "clients" && len(pathSegments) >= 3 && pathSegments[2] == "access-keys" {
+		s.handleAccessKey(w, r, pathSegments[1], pathSegments[3:])
+		return
+	}
+
+	configuration, ok := collections[pathSegments[0]]
+	if !ok || len(pathSegments) > 2 {
+		writeError(w, http.StatusNotFound, "NotFound", "The route does not exist")
+		return
+	}
+
+	if len(pathSegments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, r, pathSegments[0])
+		case http.MethodPost:
+			s.create(w, pathSegments[0], configuration, body)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not supported")
+		}
+		return
+	}
+
+	id := pathSegments[1]
+	storedItem, ok := s.items[pathSegments[0]][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("The %s does not exist", configuration.idField))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeItem(w, http.StatusOK, storedItem)
+	case http.MethodPut:
+		if !matchesPrecondition(r, storedItem) {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed", "The item was changed since it was read")
+			return
+		}
+		body[configuration.idField] = id
+		for _, computedField := range []string{ "client", "links", "accessKeys" } {
+			if _, ok := body[computedField]; !ok && storedItem.data[computedField] != nil {
+				body[computedField] = storedItem.data[computedField]
+			}
+		}
+		writeItem(w, http.StatusOK, s.store(pathSegments[0], id, body))
+	case http.MethodDelete:
+		s.remove(pathSegments[0], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not supported")
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, collection string) {
+	ids := s.order[collection]
+	start, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = s.pageSize
+	}
+	if start > len(ids) {
+		start = len(ids)
+	}
+	end := start + limit
+	if end > len(ids) {
+		end = len(ids)
+	}
+
+	page := []map[string]any{}
+	for _, id := range ids[start:end] {
+		page = append(page, s.items[collection][id].data)
+	}
+
+	pagination := map[string]any{}
+	if end < len(ids) {
+		pagination["next"] = map[string]any{ "cursor": strconv.Itoa(end) }
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{ collection: page, "pagination": pagination })
+}
+
+func (s *Server) create(w http.ResponseWriter, collection string, configuration collectionConfiguration, body map[string]any) {
+	id, _ := body[configuration.idField].(string)
+	if id == "" {
+		s.nextID++
+		id = fmt.Sprintf("%s%d", configuration.idPrefix, s.nextID)
+		body[configuration.idField] = id
+	}
+	if _, exists := s.items[collection][id]; exists {
+		writeFieldError(w, http.StatusConflict, "AlreadyExists", fmt.Sprintf("An item with the %s %s already exists", configuration.idField, id), configuration.idField)
+		return
+	}
+
+	switch collection {
+	case "extensions":
+		s.nextID++
+		body["client"] = map[string]any{ "clientId": fmt.Sprintf("sc_%d", s.nextID) }
+	case "invites":
+		body["links"] = map[string]any{ "self": fmt.Sprintf("%s/v1/invites/%s", s.URL, id) }
+	}
+
+	writeItem(w, http.StatusCreated, s.store(collection, id, body))
+}
+
+func (s *Server) handleResource(w http.ResponseWriter, r *http.Request, resourceURI string, body map[string]any) {
+	switch r.Method {
+	case http.MethodGet:
+		if resource, ok := s.resources[resourceURI]; ok {
+			writeJSON(w, http.StatusOK, resource)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{ "resourceUri": resourceURI, "permissions": []any{} })
+	case http.MethodPut:
+		body["resourceUri"] = resourceURI
+		lowercasePermissionActions(body)
+		s.resources[resourceURI] = body
+		writeJSON(w, http.StatusOK, body)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not supported")
+	}
+}
+
+func (s *Server) handleAccessKey(w http.ResponseWriter, r *http.Request, clientID string, keyPath []string) {
+	client, ok := s.items["clients"][clientID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "The clientId does not exist")
+		return
+	}
+	accessKeys, _ := client.data["accessKeys"].([]any)
+
+	switch {
+	case r.Method == http.MethodPost && len(keyPath) == 0:
+		s.nextID++
+		keyID := fmt.Sprintf("key_%d", s.nextID)
+		client.data["accessKeys"] = append(accessKeys, map[string]any{ "keyId": keyID, "clientId": clientID })
+		client.version++
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"keyId": keyID,
+			"clientId": clientID,
+			"accessKey": fmt.Sprintf("%s.%s.acc_test.test-private-key", clientID, keyID),
+		})
+	case r.Method == http.MethodDelete && len(keyPath) == 1:
+		remainingKeys := []any{}
+		for _, accessKey := range accessKeys {
+			if accessKey.(map[string]any)["keyId"] != keyPath[0] {
+				remainingKeys = append(remainingKeys, accessKey)
+			}
+		}
+		if len(remainingKeys) == len(accessKeys) {
+			writeError(w, http.StatusNotFound, "NotFound", "The keyId does not exist")
+			return
+		}
+		client.data["accessKeys"] = remainingKeys
+		client.version++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not supported")
+	}
+}
+
+func (s *Server) store(collection string, id string, data map[string]any) (*item) {
+	if s.items[collection] == nil {
+		s.items[collection] = map[string]*item{}
+	}
+
+	if collection == "roles" {
+		lowercasePermissionActions(data)
+	}
+
+	storedItem, ok := s.items[collection][id]
+	if !ok {
+		storedItem = &item{}
+		s.items[collection][id] = storedItem
+		s.order[collection] = append(s.order[collection], id)
+	}
+	storedItem.data = data
+	storedItem.version++
+	return storedItem
+}
+
+func (s *Server) remove(collection string, id string) {
+	if _, ok := s.items[collection][id]; !ok {
+		return
+	}
+	delete(s.items[collection], id)
+
+	remainingIDs := []string{}
+	for _, existingID := range s.order[collection] {
+		if existingID != id {
+			remainingIDs = append(remainingIDs, existingID)
+		}
+	}
+	s.order[collection] = remainingIDs
+}
+
+func matchesPrecondition(r *http.Request, storedItem *item) (bool) {
+	ifMatch := r.Header.Get("If-Match")
+	return ifMatch == "" || ifMatch == "*" || ifMatch == getETag(storedItem)
+}
+
+func getETag(storedItem *item) (string) {
+	return fmt.Sprintf(`"%d"`, storedItem.version)
+}
+
+func writeItem(w http.ResponseWriter, status int, storedItem *item) {
+	w.Header().Set("ETag", getETag(storedItem))
+	writeJSON(w, status, storedItem.data)
+}
+
+func writeError(w http.ResponseWriter, status int, errorCode string, title string) {
+	writeFieldError(w, status, errorCode, title, "")
+}
+
+func writeFieldError(w http.ResponseWriter, status int, errorCode string, title string, field string) {
+	errorBody := map[string]any{ "errorCode": errorCode, "title": title }
+	if field != "" {
+		errorBody["field"] = field
+	}
+	writeJSON(w, status, errorBody)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", time.Now().UnixNano()))
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func copyData(data map[string]any) (map[string]any) {
+	serializedData, _ := json.Marshal(data)
+	copiedData := map[string]any{}
+	json.Unmarshal(serializedData, &copiedData)
+	return copiedData
+}
+
+// lowercasePermissionActions stores the actions the same way as Authress, which treats them as case-insensitive.
+func lowercasePermissionActions(data map[string]any) {
+	permissions, _ := data["permissions"].([]any)
+	for _, permission := range permissions {
+		if permissionData, ok := permission.(map[string]any); ok {
+			if action, ok := permissionData["action"].(string); ok {
+				permissionData["action"] = strings.ToLower(action)
+			}
+		}
+	}
+}
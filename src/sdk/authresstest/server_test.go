@@ -0,0 +1,98 @@
+package authresstest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+	"github.com/authress/terraform-provider-authress/src/sdk/authresstest"
+)
+
+func newTestClient(t *testing.T) (*authresstest.Server, *AuthressSdk.Client) {
+	server := authresstest.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := AuthressSdk.NewClient(server.URL, "test-access-key", "0.0.0", AuthressSdk.TransportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func TestServerManagesRoles(t *testing.T) {
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateRole(ctx, AuthressSdk.Role{ RoleID: "ro_test", Name: "Test", Permissions: []AuthressSdk.Permission{ { Action: "Documents:Read", Allow: true } } })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.CreateRole(ctx, AuthressSdk.Role{ RoleID: "ro_test", Name: "Test", Permissions: []AuthressSdk.Permission{} })
+	if apiError, ok := AuthressSdk.AsAPIError(err); !ok || apiError.StatusCode != http.StatusConflict {
+		t.Fatalf("expected creating an existing role to conflict, got %v", err)
+	}
+
+	role, err := client.GetRole(ctx, "ro_test")
+	if err != nil || role == nil || role.Name != "Test" {
+		t.Fatalf("expected the created role, got %+v %v", role, err)
+	}
+	if len(role.Permissions) != 1 || role.Permissions[0].Action != "documents:read" {
+		t.Fatalf("expected the permission actions to be stored lowercase, got %+v", role.Permissions)
+	}
+
+	server.Set("roles", map[string]any{ "roleId": "ro_test", "name": "Changed in the portal", "permissions": []any{} })
+	_, err = client.UpdateRole(ctx, "ro_test", *role)
+	if apiError, ok := AuthressSdk.AsAPIError(err); !ok || apiError.StatusCode != http.StatusPreconditionFailed {
+		t.Fatalf("expected updating an outdated role to fail, got %v", err)
+	}
+
+	if err := client.DeleteRole(ctx, "ro_test"); err != nil {
+		t.Fatal(err)
+	}
+	if role, err := client.GetRole(ctx, "ro_test"); role != nil || err != nil {
+		t.Fatalf("expected the role to be deleted, got %+v %v", role, err)
+	}
+}
+
+func TestServerPaginatesLists(t *testing.T) {
+	server, client := newTestClient(t)
+	for _, groupID := range []string{ "grp_1", "grp_2", "grp_3" } {
+		server.Set("groups", map[string]any{ "groupId": groupID, "name": groupID, "users": []any{} })
+	}
+
+	server.SetPageSize(2)
+
+	groups := client.ListGroups(AuthressSdk.ListOptions{})
+	firstPage, err := groups.Next(context.Background())
+	if err != nil || len(firstPage) != 2 || !groups.HasNext() {
+		t.Fatalf("expected a first page of 2 groups, got %+v %v", firstPage, err)
+	}
+	secondPage, err := groups.Next(context.Background())
+	if err != nil || len(secondPage) != 1 || secondPage[0].GroupID != "grp_3" || groups.HasNext() {
+		t.Fatalf("expected a last page with grp_3, got %+v %v", secondPage, err)
+	}
+}
+
+func TestServerInjectsErrorsAndLatency(t *testing.T) {
+	server, client := newTestClient(t)
+	client.MaxRetries = 0
+	server.InjectError(http.MethodGet, "/v1/roles", http.StatusForbidden, 1)
+
+	_, err := client.GetRole(context.Background(), "ro_test")
+	if apiError, ok := AuthressSdk.AsAPIError(err); !ok || apiError.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected the injected error, got %v", err)
+	}
+	if role, err := client.GetRole(context.Background(), "ro_test"); role != nil || err != nil {
+		t.Fatalf("expected the error to only be injected once, got %+v %v", role, err)
+	}
+
+	server.SetLatency(time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 10 * time.Millisecond)
+	defer cancel()
+	if _, err := client.GetRole(ctx, "ro_test"); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+}
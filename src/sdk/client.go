@@ -1,53 +1,170 @@
 package authress
 
 import (
-	"fmt"
 	"io/ioutil"
+	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // HostURL - Default Authress URL
 const HostURL string = "http://localhost:19090"
 
+// DefaultMaxRetries - Default number of times a failed request is retried
+const DefaultMaxRetries int = 3
+
+const (
+	retryBaseDelay	= 500 * time.Millisecond
+	retryMaxDelay	= 30 * time.Second
+)
+
 // Client -
 type Client struct {
-	HostURL    	string
-	HTTPClient 	*http.Client
-	AccessKey  	string
-	Version		string
+	HostURL			string
+	HTTPClient		*http.Client
+	TokenProvider	TokenProvider
+	Version			string
+	MaxRetries		int
 }
 
 // NewClient -
-func NewClient(customDomain string, accessKey string, version string) (*Client, error) {
+func NewClient(customDomain string, accessKey string, version string, transportOptions TransportOptions) (*Client, error) {
+	tokenProvider, err := NewTokenProvider(customDomain, accessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newHTTPClient(transportOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		AccessKey: accessKey,
+		HTTPClient: httpClient,
+		TokenProvider: tokenProvider,
 		HostURL: customDomain,
 		Version: version,
+		MaxRetries: DefaultMaxRetries,
 	}
 
 	return &c, nil
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, int, error) {
-	req.Header.Set("Authorization", "Bearer " + c.AccessKey)
+	body, status, _, err := c.doRequestWithHeaders(req)
+	return body, status, err
+}
+
+// doRequestWithHeaders sends the request, retrying throttled and transient failures with jittered exponential backoff.
+func (c *Client) doRequestWithHeaders(req *http.Request) ([]byte, int, http.Header, error) {
+	token, err := c.TokenProvider.GetToken(req.Context())
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer " + token)
 	req.Header.Set("User-Agent", "Authress SDK; Terraform; " + c.Version + ";")
 
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			requestBody, err := req.GetBody()
+			if err != nil {
+				return nil, 0, nil, err
+			}
+			req.Body = requestBody
+		}
+
+		body, status, headers, err := c.sendRequest(req)
+		if attempt >= c.MaxRetries || !isRetryable(req, status, err) {
+			return body, status, headers, err
+		}
+
+		delay := getRetryDelay(attempt, headers.Get("Retry-After"))
+		tflog.Debug(req.Context(), "Retrying Authress API request", map[string]any{
+			"method": req.Method,
+			"url": req.URL.String(),
+			"status": status,
+			"attempt": attempt + 1,
+			"delay": delay.String(),
+		})
+
+		select {
+		case <-req.Context().Done():
+			return nil, status, headers, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *Client) sendRequest(req *http.Request) ([]byte, int, http.Header, error) {
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, http.Header{}, err
 	}
 	defer res.Body.Close()
 
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, res.StatusCode, err
+		return nil, res.StatusCode, res.Header, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, res.StatusCode, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, res.StatusCode, res.Header, newAPIError(res, body)
 	}
 
-	return body, res.StatusCode, err
+	return body, res.StatusCode, res.Header, err
+}
+
+// isRetryable only retries a POST when Authress rejected it before processing it, so that a resource is never created twice.
+func isRetryable(req *http.Request, status int, err error) (bool) {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+
+	if req.Method == http.MethodPost {
+		return false
+	}
+
+	if status == 0 {
+		return err != nil
+	}
+
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
+
+// getRetryDelay prefers the delay requested by Authress in the Retry-After header, otherwise uses full jitter exponential backoff.
+func getRetryDelay(attempt int, retryAfter string) (time.Duration) {
+	if retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return minDuration(time.Duration(seconds) * time.Second, retryMaxDelay)
+		}
+		if retryTime, err := http.ParseTime(retryAfter); err == nil {
+			return minDuration(maxDuration(time.Until(retryTime), 0), retryMaxDelay)
+		}
+	}
+
+	backoff := minDuration(time.Duration(float64(retryBaseDelay) * math.Pow(2, float64(attempt))), retryMaxDelay)
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+func minDuration(a time.Duration, b time.Duration) (time.Duration) {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxDuration(a time.Duration, b time.Duration) (time.Duration) {
+	if a > b {
+		return a
+	}
+	return b
 }
@@ -0,0 +1,140 @@
+package authress
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL, "test-access-key", "0.0.0", TransportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestDoRequestRetriesThrottledRequests(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"roleId":"ro_test","name":"Test","permissions":[]}`))
+	})
+
+	role, err := client.GetRole(context.Background(), "ro_test")
+	if err != nil {
+		t.Fatalf("expected the request to succeed after retrying, got: %s", err)
+	}
+	if role.RoleID != "ro_test" || attempts != 3 {
+		t.Fatalf("expected role ro_test after 3 attempts, got %+v after %d attempts", role, attempts)
+	}
+}
+
+func TestDoRequestResendsTheBodyOnRetry(t *testing.T) {
+	bodies := []string{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(body)
+	})
+
+	_, err := client.UpdateRole(context.Background(), "ro_test", Role{ RoleID: "ro_test", Name: "Test" })
+	if err != nil {
+		t.Fatalf("expected the request to succeed after retrying, got: %s", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[1] == "" {
+		t.Fatalf("expected the same body to be sent twice, got %q", bodies)
+	}
+}
+
+func TestDoRequestDoesNotRetryTransientPostFailures(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.CreateRole(context.Background(), Role{ RoleID: "ro_test", Name: "Test" })
+	if err == nil || attempts != 1 {
+		t.Fatalf("expected a single failed attempt, got %d attempts and error: %v", attempts, err)
+	}
+}
+
+func TestDoRequestStopsWhenTheRetryBudgetIsExhausted(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client.MaxRetries = 2
+
+	_, err := client.GetRole(context.Background(), "ro_test")
+	if err == nil || attempts != 3 {
+		t.Fatalf("expected 3 failed attempts, got %d attempts and error: %v", attempts, err)
+	}
+}
+
+func TestGetRetryDelay(t *testing.T) {
+	if delay := getRetryDelay(0, "2"); delay != 2 * time.Second {
+		t.Errorf("expected the Retry-After seconds to be used, got %s", delay)
+	}
+	if delay := getRetryDelay(0, "120"); delay != retryMaxDelay {
+		t.Errorf("expected the Retry-After delay to be capped, got %s", delay)
+	}
+	for attempt := 0; attempt < 10; attempt++ {
+		if delay := getRetryDelay(attempt, ""); delay < 0 || delay > retryMaxDelay {
+			t.Errorf("expected the backoff delay to be between 0 and %s, got %s", retryMaxDelay, delay)
+		}
+	}
+}
+
+func TestDoRequestReturnsAPIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-001")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errorCode":"RoleAlreadyExists","title":"A role with this roleId already exists","field":"roleId"}`))
+	})
+
+	_, err := client.CreateRole(context.Background(), Role{ RoleID: "ro_test", Name: "Test" })
+	apiError, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected an APIError, got: %v", err)
+	}
+	if apiError.StatusCode != http.StatusConflict || apiError.ErrorCode != "RoleAlreadyExists" || apiError.Field != "roleId" || apiError.RequestID != "req-001" {
+		t.Fatalf("expected the error body to be parsed, got %+v", apiError)
+	}
+}
+
+func TestDoRequestReturnsAPIErrorForNonJsonBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`Unauthorized`))
+	})
+
+	_, err := client.GetRole(context.Background(), "ro_test")
+	apiError, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected an APIError, got: %v", err)
+	}
+	if apiError.StatusCode != http.StatusUnauthorized || apiError.Body != "Unauthorized" || err.Error() != "status: 401, body: Unauthorized" {
+		t.Fatalf("expected the raw body to be kept, got %+v", apiError)
+	}
+}
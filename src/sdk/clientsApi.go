@@ -0,0 +1,130 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetServiceClient(ctx context.Context, clientID string) (*ServiceClient, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/clients/%s", c.HostURL, clientID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	serviceClient := ServiceClient{}
+	err = json.Unmarshal(body, &serviceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceClient, nil
+}
+
+func (c *Client) CreateServiceClient(ctx context.Context, serviceClient ServiceClient) (*ServiceClient, error) {
+	rb, err := json.Marshal(serviceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/clients", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newClient := ServiceClient{}
+	err = json.Unmarshal(body, &newClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newClient, nil
+}
+
+func (c *Client) UpdateServiceClient(ctx context.Context, clientID string, serviceClient ServiceClient) (*ServiceClient, error) {
+	rb, err := json.Marshal(serviceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/clients/%s", c.HostURL, clientID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newClient := ServiceClient{}
+	err = json.Unmarshal(body, &newClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newClient, nil
+}
+
+func (c *Client) DeleteServiceClient(ctx context.Context, clientID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/clients/%s", c.HostURL, clientID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+func (c *Client) CreateServiceClientAccessKey(ctx context.Context, clientID string) (*ServiceClientAccessKey, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/clients/%s/access-keys", c.HostURL, clientID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newAccessKey := ServiceClientAccessKey{}
+	err = json.Unmarshal(body, &newAccessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newAccessKey, nil
+}
+
+func (c *Client) DeleteServiceClientAccessKey(ctx context.Context, clientID string, keyID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/clients/%s/access-keys/%s", c.HostURL, clientID, keyID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,96 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetConnection(ctx context.Context, connectionID string) (*Connection, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/connections/%s", c.HostURL, connectionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	connection := Connection{}
+	err = json.Unmarshal(body, &connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection, nil
+}
+
+func (c *Client) CreateConnection(ctx context.Context, connection Connection) (*Connection, error) {
+	rb, err := json.Marshal(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/connections", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newConnection := Connection{}
+	err = json.Unmarshal(body, &newConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newConnection, nil
+}
+
+func (c *Client) UpdateConnection(ctx context.Context, connectionID string, connection Connection) (*Connection, error) {
+	rb, err := json.Marshal(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/connections/%s", c.HostURL, connectionID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newConnection := Connection{}
+	err = json.Unmarshal(body, &newConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newConnection, nil
+}
+
+func (c *Client) DeleteConnection(ctx context.Context, connectionID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/connections/%s", c.HostURL, connectionID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
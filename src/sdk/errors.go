@@ -0,0 +1,88 @@
+package authress
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError - Error response returned by the Authress API
+type APIError struct {
+	StatusCode	int
+	ErrorCode	string
+	Title		string
+	Detail		string
+	Field		string
+	RequestID	string
+	Body		string
+}
+
+type apiErrorBody struct {
+	ErrorCode	string	`json:"errorCode"`
+	ErrorID		string	`json:"errorId"`
+	Title		string	`json:"title"`
+	Detail		string	`json:"detail"`
+	Details		string	`json:"details"`
+	Field		string	`json:"field"`
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorCode == "" && e.Title == "" {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+	}
+
+	message := fmt.Sprintf("status: %d, errorCode: %s, title: %s", e.StatusCode, e.ErrorCode, e.Title)
+	if e.Detail != "" {
+		message += ", detail: " + e.Detail
+	}
+	if e.Field != "" {
+		message += ", field: " + e.Field
+	}
+	if e.RequestID != "" {
+		message += ", requestId: " + e.RequestID
+	}
+	return message
+}
+
+// AsAPIError returns the Authress API error wrapped by err, if there is one.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return apiError, true
+	}
+	return nil, false
+}
+
+// newAPIError parses the error body, Authress error responses are JSON but proxies in front of the custom domain may return anything.
+func newAPIError(res *http.Response, body []byte) (*APIError) {
+	apiError := &APIError{
+		StatusCode: res.StatusCode,
+		RequestID: getRequestID(res.Header),
+		Body: string(body),
+	}
+
+	var parsedBody apiErrorBody
+	if err := json.Unmarshal(body, &parsedBody); err != nil {
+		return apiError
+	}
+
+	apiError.ErrorCode = parsedBody.ErrorCode
+	apiError.Title = parsedBody.Title
+	apiError.Detail = parsedBody.Detail
+	if apiError.Detail == "" {
+		apiError.Detail = parsedBody.Details
+	}
+	apiError.Field = parsedBody.Field
+	if apiError.RequestID == "" {
+		apiError.RequestID = parsedBody.ErrorID
+	}
+	return apiError
+}
+
+func getRequestID(headers http.Header) (string) {
+	if requestID := headers.Get("X-Request-Id"); requestID != "" {
+		return requestID
+	}
+	return headers.Get("X-Amzn-RequestId")
+}
@@ -0,0 +1,96 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetExtension(ctx context.Context, extensionID string) (*Extension, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/extensions/%s", c.HostURL, extensionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	extension := Extension{}
+	err = json.Unmarshal(body, &extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extension, nil
+}
+
+func (c *Client) CreateExtension(ctx context.Context, extension Extension) (*Extension, error) {
+	rb, err := json.Marshal(extension)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/extensions", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newExtension := Extension{}
+	err = json.Unmarshal(body, &newExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newExtension, nil
+}
+
+func (c *Client) UpdateExtension(ctx context.Context, extensionID string, extension Extension) (*Extension, error) {
+	rb, err := json.Marshal(extension)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/extensions/%s", c.HostURL, extensionID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newExtension := Extension{}
+	err = json.Unmarshal(body, &newExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newExtension, nil
+}
+
+func (c *Client) DeleteExtension(ctx context.Context, extensionID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/extensions/%s", c.HostURL, extensionID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,100 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) ListGroups(options ListOptions) (*PageIterator[Group]) {
+	return newPageIterator[Group](c, "/v1/groups", "groups", options)
+}
+
+func (c *Client) GetGroup(ctx context.Context, groupID string) (*Group, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/groups/%s", c.HostURL, groupID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	group := Group{}
+	err = json.Unmarshal(body, &group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (c *Client) CreateGroup(ctx context.Context, group Group) (*Group, error) {
+	rb, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/groups", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newGroup := Group{}
+	err = json.Unmarshal(body, &newGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newGroup, nil
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, groupID string, group Group) (*Group, error) {
+	rb, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/groups/%s", c.HostURL, groupID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newGroup := Group{}
+	err = json.Unmarshal(body, &newGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newGroup, nil
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/groups/%s", c.HostURL, groupID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
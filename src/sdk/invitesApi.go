@@ -0,0 +1,71 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetInvite(ctx context.Context, inviteID string) (*Invite, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/invites/%s", c.HostURL, inviteID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	invite := Invite{}
+	err = json.Unmarshal(body, &invite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+func (c *Client) CreateInvite(ctx context.Context, invite Invite) (*Invite, error) {
+	rb, err := json.Marshal(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/invites", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newInvite := Invite{}
+	err = json.Unmarshal(body, &newInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newInvite, nil
+}
+
+func (c *Client) DeleteInvite(ctx context.Context, inviteID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/invites/%s", c.HostURL, inviteID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,158 @@
+package authress
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "***"
+
+// Fields that contain access keys or tokens are never logged, the comparison is case-insensitive.
+var redactedBodyFields = map[string]bool{
+	"accesskey": true,
+	"access_key": true,
+	"access_token": true,
+	"clientsecret": true,
+	"client_secret": true,
+	"subject_token": true,
+	"privatekey": true,
+	"token": true,
+	"value": true,
+	"password": true,
+}
+
+// Headers that contain credentials are never logged, the comparison uses the canonical header name.
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+	"Proxy-Authorization": true,
+	"Cookie": true,
+	"Set-Cookie": true,
+}
+
+// loggingRoundTripper logs every request to the Authress API, the bodies are only logged at trace level.
+type loggingRoundTripper struct {
+	next	http.RoundTripper
+}
+
+func (t *loggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	requestBody := []byte{}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = ioutil.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	tflog.Trace(ctx, "Authress API request", map[string]any{
+		"method": req.Method,
+		"url": req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body": redactBody(requestBody),
+	})
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.Debug(ctx, "Authress API request failed", map[string]any{
+			"method": req.Method,
+			"url": req.URL.String(),
+			"latency_ms": latency.Milliseconds(),
+			"error": err.Error(),
+		})
+		return res, err
+	}
+
+	// A RoundTripper must not return both a response and an error, so the response is dropped when its body cannot be read.
+	responseBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		tflog.Debug(ctx, "Authress API response could not be read", map[string]any{
+			"method": req.Method,
+			"url": req.URL.String(),
+			"status": res.StatusCode,
+			"latency_ms": time.Since(start).Milliseconds(),
+			"request_id": getRequestID(res.Header),
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	tflog.Debug(ctx, "Authress API response", map[string]any{
+		"method": req.Method,
+		"url": req.URL.String(),
+		"status": res.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"request_id": getRequestID(res.Header),
+	})
+	tflog.Trace(ctx, "Authress API response body", map[string]any{
+		"request_id": getRequestID(res.Header),
+		"headers": redactHeaders(res.Header),
+		"body": redactBody(responseBody),
+	})
+
+	return res, nil
+}
+
+func redactHeaders(headers http.Header) (map[string]string) {
+	redacted := map[string]string{}
+	for name, values := range headers {
+		if redactedHeaders[http.CanonicalHeaderKey(name)] {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+// redactBody replaces the secret fields of JSON bodies, any other body is only logged when it cannot contain JSON secrets.
+func redactBody(body []byte) (string) {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var parsedBody any
+	if err := json.Unmarshal(body, &parsedBody); err != nil {
+		if strings.HasPrefix(strings.TrimSpace(string(body)), "<") {
+			return string(body)
+		}
+		return redactedValue
+	}
+
+	redactedBody, err := json.Marshal(redactValue(parsedBody))
+	if err != nil {
+		return redactedValue
+	}
+	return string(redactedBody)
+}
+
+func redactValue(value any) (any) {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		for key, nestedValue := range typedValue {
+			if redactedBodyFields[strings.ToLower(key)] {
+				typedValue[key] = redactedValue
+				continue
+			}
+			typedValue[key] = redactValue(nestedValue)
+		}
+		return typedValue
+	case []any:
+		for index, nestedValue := range typedValue {
+			typedValue[index] = redactValue(nestedValue)
+		}
+		return typedValue
+	default:
+		return value
+	}
+}
@@ -0,0 +1,78 @@
+package authress
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	redactedBody := redactBody([]byte(`{"clientId":"sc_test","accessKeys":[{"keyId":"key_test","accessKey":"secret-access-key"}],"data":{"clientSecret":"secret-client-secret"}}`))
+	if strings.Contains(redactedBody, "secret-") {
+		t.Fatalf("expected the secrets to be redacted, got %s", redactedBody)
+	}
+	if !strings.Contains(redactedBody, `"keyId":"key_test"`) || !strings.Contains(redactedBody, `"clientId":"sc_test"`) {
+		t.Fatalf("expected the other fields to be kept, got %s", redactedBody)
+	}
+
+	if redactedBody := redactBody([]byte(`secret-token`)); redactedBody != redactedValue {
+		t.Fatalf("expected a body that is not JSON to be redacted, got %s", redactedBody)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret-token")
+	headers.Set("User-Agent", "Authress SDK")
+
+	redactedHeaders := redactHeaders(headers)
+	if redactedHeaders["Authorization"] != redactedValue || redactedHeaders["User-Agent"] != "Authress SDK" {
+		t.Fatalf("expected only the Authorization header to be redacted, got %v", redactedHeaders)
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type failingBody struct {}
+
+func (b failingBody) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (b failingBody) Close() error {
+	return nil
+}
+
+func TestLoggingRoundTripperResponseBodyFailure(t *testing.T) {
+	roundTripper := &loggingRoundTripper{ next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{ StatusCode: http.StatusOK, Header: http.Header{}, Body: failingBody{} }, nil
+	}) }
+
+	req, _ := http.NewRequest("GET", "https://login.example.com/v1/roles", nil)
+	res, err := roundTripper.RoundTrip(req)
+	if res != nil || err == nil {
+		t.Fatalf("expected only the error to be returned, got %v %v", res, err)
+	}
+}
+
+func TestLoggingRoundTripperKeepsResponseBody(t *testing.T) {
+	roundTripper := &loggingRoundTripper{ next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{ StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(`{"roles":[]}`)) }, nil
+	}) }
+
+	req, _ := http.NewRequest("GET", "https://login.example.com/v1/roles", nil)
+	res, err := roundTripper.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	if string(body) != `{"roles":[]}` {
+		t.Fatalf("expected the response body to still be readable, got %s", body)
+	}
+}
@@ -5,6 +5,8 @@ type Role struct {
 	Name 		string			`json:"name"`
 	Description string 			`json:"description,omitempty"`
 	Permissions []Permission	`json:"permissions"`
+	// Version is the ETag or Last-Modified header of the role, it is sent as the precondition of updates
+	Version		string			`json:"-"`
 }
 
 type Permission struct {
@@ -13,3 +15,137 @@ type Permission struct {
 	Grant		bool	`json:"grant"`
 	Delegate	bool	`json:"delegate"`
 }
+
+type AccessRecord struct {
+	RecordID	string			`json:"recordId"`
+	Name		string			`json:"name"`
+	Description	string			`json:"description,omitempty"`
+	Users		[]User			`json:"users"`
+	Admins		[]User			`json:"admins,omitempty"`
+	Groups		[]LinkedGroup	`json:"groups,omitempty"`
+	Statements	[]Statement		`json:"statements"`
+}
+
+type User struct {
+	UserID		string	`json:"userId"`
+}
+
+type LinkedGroup struct {
+	GroupID		string	`json:"groupId"`
+}
+
+type Statement struct {
+	Roles		[]string	`json:"roles"`
+	Resources	[]Resource	`json:"resources"`
+}
+
+type Resource struct {
+	ResourceURI	string	`json:"resourceUri"`
+}
+
+type Group struct {
+	GroupID		string	`json:"groupId"`
+	Name		string	`json:"name"`
+	Users		[]User	`json:"users"`
+	Admins		[]User	`json:"admins,omitempty"`
+}
+
+type Tenant struct {
+	TenantID				string				`json:"tenantId"`
+	TenantLookupIdentifier	string				`json:"tenantLookupIdentifier,omitempty"`
+	Data					*TenantData			`json:"data,omitempty"`
+	Connection				*TenantConnection	`json:"connection,omitempty"`
+}
+
+type TenantData struct {
+	Name	string	`json:"name,omitempty"`
+}
+
+type TenantConnection struct {
+	ConnectionID	string	`json:"connectionId"`
+}
+
+type Connection struct {
+	ConnectionID		string			`json:"connectionId,omitempty"`
+	Type				string			`json:"type"`
+	IssuerURL			string			`json:"issuerUrl,omitempty"`
+	AuthenticationURL	string			`json:"authenticationUrl,omitempty"`
+	TokenURL			string			`json:"tokenUrl,omitempty"`
+	ClientID			string			`json:"clientId,omitempty"`
+	ClientSecret		string			`json:"clientSecret,omitempty"`
+	ProviderCertificate	string			`json:"providerCertificate,omitempty"`
+	Data				*ConnectionData	`json:"data,omitempty"`
+}
+
+type ConnectionData struct {
+	Name	string	`json:"name,omitempty"`
+}
+
+type ServiceClient struct {
+	ClientID		string						`json:"clientId,omitempty"`
+	Name			string						`json:"name"`
+	Description		string						`json:"description,omitempty"`
+	RedirectURLs	[]string					`json:"redirectUrls,omitempty"`
+	Options			*ServiceClientOptions		`json:"options,omitempty"`
+	AccessKeys		[]ServiceClientAccessKey	`json:"accessKeys,omitempty"`
+}
+
+type ServiceClientOptions struct {
+	GrantUserPermissionsAccess	bool	`json:"grantUserPermissionsAccess"`
+	GrantMetadataAccess			bool	`json:"grantMetadataAccess"`
+}
+
+type ServiceClientAccessKey struct {
+	KeyID		string	`json:"keyId"`
+	ClientID	string	`json:"clientId,omitempty"`
+	AccessKey	string	`json:"accessKey,omitempty"`
+}
+
+type Application struct {
+	ApplicationID	string		`json:"applicationId,omitempty"`
+	Name			string		`json:"name"`
+	Description		string		`json:"description,omitempty"`
+	RedirectURLs	[]string	`json:"redirectUrls"`
+	AllowedOrigins	[]string	`json:"allowedOrigins"`
+}
+
+type Extension struct {
+	ExtensionID	string					`json:"extensionId,omitempty"`
+	Name		string					`json:"name"`
+	Application	ExtensionApplication	`json:"application"`
+	Client		*ExtensionClient		`json:"client,omitempty"`
+}
+
+type ExtensionApplication struct {
+	RedirectURLs	[]string	`json:"redirectUrls"`
+}
+
+type ExtensionClient struct {
+	ClientID	string	`json:"clientId"`
+}
+
+type ResourcePermission struct {
+	ResourceURI	string			`json:"resourceUri,omitempty"`
+	Permissions	[]Permission	`json:"permissions"`
+}
+
+type Invite struct {
+	InviteID	string			`json:"inviteId,omitempty"`
+	TenantID	string			`json:"tenantId,omitempty"`
+	Statements	[]Statement		`json:"statements"`
+	Links		*InviteLinks	`json:"links,omitempty"`
+}
+
+type InviteLinks struct {
+	Self	string	`json:"self"`
+}
+
+type AccountIdentity struct {
+	IdentityID	string	`json:"identityId,omitempty"`
+	Issuer		string	`json:"issuer"`
+	Audience	string	`json:"audience"`
+}
+
+type AccountIdentityCollection struct {
+	Identities	[]AccountIdentity	`json:"identities"`
+}
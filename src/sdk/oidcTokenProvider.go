@@ -0,0 +1,213 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	oidcTokenRefresh			= 1 * time.Minute
+	oidcTokenDefaultLifetime	= 5 * time.Minute
+	oidcTokenExchangeGrantType	= "urn:ietf:params:oauth:grant-type:token-exchange"
+	oidcTokenType				= "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// OidcConfiguration - Configures where the CI/CD identity token is read from and where it is exchanged
+type OidcConfiguration struct {
+	TokenURL	string
+	ClientID	string
+	Audience	string
+	TokenFile	string
+}
+
+// OidcTokenProvider - Exchanges the identity token of the CI/CD platform for an Authress access token
+type OidcTokenProvider struct {
+	Configuration	OidcConfiguration
+	HTTPClient		*http.Client
+	now				func() time.Time
+
+	mutex			sync.Mutex
+	token			string
+	expiresAt		time.Time
+}
+
+type githubIdentityTokenResponse struct {
+	Value	string	`json:"value"`
+}
+
+type oidcTokenExchangeRequest struct {
+	GrantType			string	`json:"grant_type"`
+	SubjectToken		string	`json:"subject_token"`
+	SubjectTokenType	string	`json:"subject_token_type"`
+	ClientID			string	`json:"client_id,omitempty"`
+}
+
+type oidcTokenExchangeResponse struct {
+	AccessToken	string	`json:"access_token"`
+	ExpiresIn	int64	`json:"expires_in"`
+}
+
+// NewOidcTokenProvider defaults the token endpoint and the audience of the identity token to the custom domain.
+func NewOidcTokenProvider(customDomain string, configuration OidcConfiguration) (*OidcTokenProvider) {
+	host := strings.TrimSuffix(customDomain, "/")
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "https://" + host
+	}
+	if configuration.TokenURL == "" {
+		configuration.TokenURL = host + "/api/authentication/oauth/tokens"
+	}
+	if configuration.Audience == "" {
+		configuration.Audience = host
+	}
+
+	return &OidcTokenProvider{
+		Configuration: configuration,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		now: time.Now,
+	}
+}
+
+// GetToken returns the cached access token and only exchanges a new identity token when the cached one is about to expire.
+func (p *OidcTokenProvider) GetToken(ctx context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	if p.token != "" && now.Add(oidcTokenRefresh).Before(p.expiresAt) {
+		return p.token, nil
+	}
+
+	identityToken, err := p.getIdentityToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	exchangedToken, err := p.exchangeIdentityToken(ctx, identityToken)
+	if err != nil {
+		return "", err
+	}
+
+	lifetime := oidcTokenDefaultLifetime
+	if exchangedToken.ExpiresIn > 0 {
+		lifetime = time.Duration(exchangedToken.ExpiresIn) * time.Second
+	}
+	p.token = exchangedToken.AccessToken
+	p.expiresAt = now.Add(lifetime)
+	return p.token, nil
+}
+
+// getIdentityToken prefers the configured token file, then GitHub Actions, then the GitLab CI token file.
+func (p *OidcTokenProvider) getIdentityToken(ctx context.Context) (string, error) {
+	if p.Configuration.TokenFile != "" {
+		return readIdentityTokenFile(p.Configuration.TokenFile)
+	}
+
+	requestURL, requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"), os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL != "" && requestToken != "" {
+		return p.getGithubIdentityToken(ctx, requestURL, requestToken)
+	}
+
+	if tokenFile := os.Getenv("CI_JOB_JWT_FILE"); tokenFile != "" {
+		return readIdentityTokenFile(tokenFile)
+	}
+
+	return "", fmt.Errorf("no CI/CD identity token found, set the oidc token_file or run in GitHub Actions with the id-token: write permission")
+}
+
+func (p *OidcTokenProvider) getGithubIdentityToken(ctx context.Context, requestURL string, requestToken string) (string, error) {
+	parsedURL, err := url.Parse(requestURL)
+	if err != nil {
+		return "", err
+	}
+	query := parsedURL.Query()
+	query.Set("audience", p.Configuration.Audience)
+	parsedURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", parsedURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer " + requestToken)
+
+	body, err := p.sendRequest(req)
+	if err != nil {
+		return "", fmt.Errorf("could not request the GitHub Actions identity token: %w", err)
+	}
+
+	identityToken := githubIdentityTokenResponse{}
+	err = json.Unmarshal(body, &identityToken)
+	if err != nil {
+		return "", err
+	}
+	if identityToken.Value == "" {
+		return "", fmt.Errorf("GitHub Actions did not return an identity token")
+	}
+	return identityToken.Value, nil
+}
+
+func (p *OidcTokenProvider) exchangeIdentityToken(ctx context.Context, identityToken string) (*oidcTokenExchangeResponse, error) {
+	rb, err := json.Marshal(oidcTokenExchangeRequest{
+		GrantType: oidcTokenExchangeGrantType,
+		SubjectToken: identityToken,
+		SubjectTokenType: oidcTokenType,
+		ClientID: p.Configuration.ClientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.Configuration.TokenURL, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := p.sendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not exchange the CI/CD identity token with Authress: %w", err)
+	}
+
+	exchangedToken := oidcTokenExchangeResponse{}
+	err = json.Unmarshal(body, &exchangedToken)
+	if err != nil {
+		return nil, err
+	}
+	if exchangedToken.AccessToken == "" {
+		return nil, fmt.Errorf("Authress did not return an access token for the CI/CD identity token")
+	}
+	return &exchangedToken, nil
+}
+
+func (p *OidcTokenProvider) sendRequest(req *http.Request) ([]byte, error) {
+	res, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, newAPIError(res, body)
+	}
+	return body, nil
+}
+
+func readIdentityTokenFile(tokenFile string) (string, error) {
+	identityToken, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("could not read the CI/CD identity token file: %w", err)
+	}
+	return strings.TrimSpace(string(identityToken)), nil
+}
@@ -0,0 +1,91 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestTokenEndpoint(t *testing.T, expectedIdentityToken string) (*httptest.Server, *int) {
+	exchanges := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges++
+		exchangeRequest := oidcTokenExchangeRequest{}
+		json.NewDecoder(r.Body).Decode(&exchangeRequest)
+		if exchangeRequest.GrantType != oidcTokenExchangeGrantType || exchangeRequest.SubjectToken != expectedIdentityToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errorCode":"InvalidToken","title":"The identity token is not trusted"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token":"authress-access-token","expires_in":3600}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &exchanges
+}
+
+func TestOidcTokenProviderExchangesGithubToken(t *testing.T) {
+	github := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer github-request-token" || r.URL.Query().Get("audience") != "https://login.example.com" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"value":"github-identity-token"}`))
+	}))
+	t.Cleanup(github.Close)
+	tokenEndpoint, exchanges := newTestTokenEndpoint(t, "github-identity-token")
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", github.URL + "/token?api-version=2.0")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "github-request-token")
+
+	tokenProvider := NewOidcTokenProvider("login.example.com", OidcConfiguration{ TokenURL: tokenEndpoint.URL })
+	for i := 0; i < 2; i++ {
+		token, err := tokenProvider.GetToken(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "authress-access-token" {
+			t.Fatalf("expected the exchanged access token, got %s", token)
+		}
+	}
+	if *exchanges != 1 {
+		t.Fatalf("expected the access token to be cached, got %d exchanges", *exchanges)
+	}
+}
+
+func TestOidcTokenProviderReadsTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("gitlab-identity-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tokenEndpoint, _ := newTestTokenEndpoint(t, "gitlab-identity-token")
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "")
+	t.Setenv("CI_JOB_JWT_FILE", tokenFile)
+
+	tokenProvider := NewOidcTokenProvider("login.example.com", OidcConfiguration{ TokenURL: tokenEndpoint.URL })
+	token, err := tokenProvider.GetToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "authress-access-token" {
+		t.Fatalf("expected the exchanged access token, got %s", token)
+	}
+}
+
+func TestOidcTokenProviderReturnsRejectedExchange(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("untrusted-identity-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tokenEndpoint, _ := newTestTokenEndpoint(t, "gitlab-identity-token")
+
+	tokenProvider := NewOidcTokenProvider("login.example.com", OidcConfiguration{ TokenURL: tokenEndpoint.URL, TokenFile: tokenFile })
+	_, err := tokenProvider.GetToken(context.Background())
+	if apiError, ok := AsAPIError(err); !ok || apiError.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the rejected exchange to be returned as an APIError, got %v", err)
+	}
+}
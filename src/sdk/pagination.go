@@ -0,0 +1,129 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions - Limits the total number of items returned by a list and filters them
+type ListOptions struct {
+	Limit	int
+	Filters	map[string]string
+}
+
+type Pagination struct {
+	Next	*PaginationCursor	`json:"next,omitempty"`
+}
+
+type PaginationCursor struct {
+	Cursor	string	`json:"cursor"`
+}
+
+// PageIterator - Follows the pagination cursor of an Authress list endpoint, one page per request
+type PageIterator[T any] struct {
+	client		*Client
+	path		string
+	itemsKey	string
+	options		ListOptions
+	cursor		string
+	returned	int
+	done		bool
+}
+
+func newPageIterator[T any](client *Client, path string, itemsKey string, options ListOptions) (*PageIterator[T]) {
+	return &PageIterator[T]{
+		client: client,
+		path: path,
+		itemsKey: itemsKey,
+		options: options,
+	}
+}
+
+// HasNext is false once the last page was returned or the limit was reached.
+func (it *PageIterator[T]) HasNext() (bool) {
+	return !it.done
+}
+
+// Next requests the next page, the returned page never exceeds the limit of the iterator.
+func (it *PageIterator[T]) Next(ctx context.Context) ([]T, error) {
+	if it.done {
+		return []T{}, nil
+	}
+
+	query := url.Values{}
+	for filter, value := range it.options.Filters {
+		query.Set(filter, value)
+	}
+	if it.options.Limit > 0 {
+		query.Set("limit", strconv.Itoa(it.options.Limit - it.returned))
+	}
+	if it.cursor != "" {
+		query.Set("cursor", it.cursor)
+	}
+
+	requestURL := fmt.Sprintf("%s%s", it.client.HostURL, it.path)
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := it.client.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page := map[string]json.RawMessage{}
+	err = json.Unmarshal(body, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []T{}
+	if rawItems, ok := page[it.itemsKey]; ok {
+		err = json.Unmarshal(rawItems, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pagination := Pagination{}
+	if rawPagination, ok := page["pagination"]; ok {
+		err = json.Unmarshal(rawPagination, &pagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if it.options.Limit > 0 && it.returned + len(items) >= it.options.Limit {
+		items = items[:it.options.Limit - it.returned]
+		it.done = true
+	}
+	if pagination.Next == nil || pagination.Next.Cursor == "" || pagination.Next.Cursor == it.cursor {
+		it.done = true
+	} else {
+		it.cursor = pagination.Next.Cursor
+	}
+
+	it.returned += len(items)
+	return items, nil
+}
+
+// All follows every remaining page and returns the combined items.
+func (it *PageIterator[T]) All(ctx context.Context) ([]T, error) {
+	items := []T{}
+	for it.HasNext() {
+		page, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+	}
+	return items, nil
+}
@@ -0,0 +1,50 @@
+package authress
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func newPaginatedRolesClient(t *testing.T, requests *[]string) (*Client) {
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"roles":[{"roleId":"ro_1"},{"roleId":"ro_2"}],"pagination":{"next":{"cursor":"page2"}}}`))
+		case "page2":
+			w.Write([]byte(`{"roles":[{"roleId":"ro_3"},{"roleId":"ro_4"}],"pagination":{"next":{"cursor":"page3"}}}`))
+		default:
+			w.Write([]byte(`{"roles":[{"roleId":"ro_5"}],"pagination":{}}`))
+		}
+	})
+}
+
+func TestPageIteratorFollowsCursor(t *testing.T) {
+	requests := []string{}
+	client := newPaginatedRolesClient(t, &requests)
+
+	roles, err := client.GetRoles(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 5 || roles[4].RoleID != "ro_5" || len(requests) != 3 {
+		t.Fatalf("expected 5 roles from 3 pages, got %+v from %d requests", roles, len(requests))
+	}
+}
+
+func TestPageIteratorStopsAtLimit(t *testing.T) {
+	requests := []string{}
+	client := newPaginatedRolesClient(t, &requests)
+
+	roles, err := client.ListRoles(ListOptions{ Limit: 3, Filters: map[string]string{ "filter": "ro_" } }).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 3 || roles[2].RoleID != "ro_3" || len(requests) != 2 {
+		t.Fatalf("expected 3 roles from 2 pages, got %+v from %d requests", roles, len(requests))
+	}
+	if requests[0] != "filter=ro_&limit=3" || requests[1] != "cursor=page2&filter=ro_&limit=1" {
+		t.Fatalf("expected the filters and remaining limit to be sent, got %v", requests)
+	}
+}
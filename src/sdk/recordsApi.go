@@ -0,0 +1,100 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) ListRecords(options ListOptions) (*PageIterator[AccessRecord]) {
+	return newPageIterator[AccessRecord](c, "/v1/records", "records", options)
+}
+
+func (c *Client) GetRecord(ctx context.Context, recordID string) (*AccessRecord, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/records/%s", c.HostURL, recordID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := AccessRecord{}
+	err = json.Unmarshal(body, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (c *Client) CreateRecord(ctx context.Context, record AccessRecord) (*AccessRecord, error) {
+	rb, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/records", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newRecord := AccessRecord{}
+	err = json.Unmarshal(body, &newRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newRecord, nil
+}
+
+func (c *Client) UpdateRecord(ctx context.Context, recordID string, record AccessRecord) (*AccessRecord, error) {
+	rb, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/records/%s", c.HostURL, recordID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newRecord := AccessRecord{}
+	err = json.Unmarshal(body, &newRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newRecord, nil
+}
+
+func (c *Client) DeleteRecord(ctx context.Context, recordID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/records/%s", c.HostURL, recordID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,58 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetResourcePermission(ctx context.Context, resourceURI string) (*ResourcePermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/resources/%s", c.HostURL, url.PathEscape(resourceURI)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resourcePermission := ResourcePermission{}
+	err = json.Unmarshal(body, &resourcePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourcePermission, nil
+}
+
+func (c *Client) UpdateResourcePermission(ctx context.Context, resourceURI string, resourcePermission ResourcePermission) (*ResourcePermission, error) {
+	rb, err := json.Marshal(resourcePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/resources/%s", c.HostURL, url.PathEscape(resourceURI)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newResourcePermission := ResourcePermission{}
+	err = json.Unmarshal(body, &newResourcePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newResourcePermission, nil
+}
@@ -1,39 +1,30 @@
 package authress
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
-)
 
-func (c *Client) GetRoles() ([]Role, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v1/roles", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, _, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
 
-	roles := []Role{}
-	err = json.Unmarshal(body, &roles)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) ListRoles(options ListOptions) (*PageIterator[Role]) {
+	return newPageIterator[Role](c, "/v1/roles", "roles", options)
+}
 
-	return roles, nil
+func (c *Client) GetRoles(ctx context.Context) ([]Role, error) {
+	return c.ListRoles(ListOptions{}).All(ctx)
 }
 
-func (c *Client) GetRole(roleID string) (*Role, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v1/roles/%s", c.HostURL, roleID), nil)
+func (c *Client) GetRole(ctx context.Context, roleID string) (*Role, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/roles/%s", c.HostURL, roleID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, status, err := c.doRequest(req)
+	body, status, headers, err := c.doRequestWithHeaders(req)
 	if status == http.StatusNotFound {
 		return nil, nil
 	}
@@ -47,21 +38,22 @@ func (c *Client) GetRole(roleID string) (*Role, error) {
 		return nil, err
 	}
 
+	role.Version = getRoleVersion(ctx, headers)
 	return &role, nil
 }
 
-func (c *Client) CreateRole(role Role) (*Role, error) {
+func (c *Client) CreateRole(ctx context.Context, role Role) (*Role, error) {
 	rb, err := json.Marshal(role)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v1/roles", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/roles", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, _, err := c.doRequest(req)
+	body, _, headers, err := c.doRequestWithHeaders(req)
 	if err != nil {
 		return nil, err
 	}
@@ -72,21 +64,23 @@ func (c *Client) CreateRole(role Role) (*Role, error) {
 		return nil, err
 	}
 
+	newRole.Version = getRoleVersion(ctx, headers)
 	return &newRole, nil
 }
 
-func (c *Client) UpdateRole(roleID string, role Role) (*Role, error) {
+func (c *Client) UpdateRole(ctx context.Context, roleID string, role Role) (*Role, error) {
 	rb, err := json.Marshal(role)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/v1/roles/%s", c.HostURL, roleID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/roles/%s", c.HostURL, roleID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
+	setRolePrecondition(req, role.Version)
 
-	body, _, err := c.doRequest(req)
+	body, _, headers, err := c.doRequestWithHeaders(req)
 	if err != nil {
 		return nil, err
 	}
@@ -97,25 +91,55 @@ func (c *Client) UpdateRole(roleID string, role Role) (*Role, error) {
 		return nil, err
 	}
 
+	newRole.Version = getRoleVersion(ctx, headers)
 	return &newRole, nil
 }
 
 
-func (c *Client) DeleteRole(roleID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v1/roles/%s", c.HostURL, roleID), nil)
+func (c *Client) DeleteRole(ctx context.Context, roleID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/roles/%s", c.HostURL, roleID), nil)
 	if err != nil {
 		return err
 	}
 
 	_, status, err := c.doRequest(req)
-
-	if status < http.StatusInternalServerError {
+	if status == http.StatusNotFound || status == http.StatusGone {
 		return nil
 	}
-
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// getRoleVersion returns an empty version when Authress does not return either header, updates then cannot detect changes made outside of Terraform.
+func getRoleVersion(ctx context.Context, headers http.Header) (string) {
+	if etag := headers.Get("ETag"); etag != "" {
+		return etag
+	}
+	if lastModified := headers.Get("Last-Modified"); lastModified != "" {
+		return lastModified
+	}
+
+	tflog.Warn(ctx, "Authress API response does not contain an ETag or Last-Modified header, changes to the role outside of Terraform will not be detected on update", map[string]any{
+		"request_id": getRequestID(headers),
+	})
+	return ""
+}
+
+// setRolePrecondition only lets the update succeed when the role has not changed since the version was read.
+func setRolePrecondition(req *http.Request, version string) {
+	if version == "" {
+		return
+	}
+	if strings.HasPrefix(version, "\"") || strings.HasPrefix(version, "W/") {
+		req.Header.Set("If-Match", version)
+		return
+	}
+	if _, err := http.ParseTime(version); err == nil {
+		req.Header.Set("If-Unmodified-Since", version)
+		return
+	}
+	req.Header.Set("If-Match", version)
+}
@@ -0,0 +1,111 @@
+package authress
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateRoleSendsVersionPrecondition(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte(`{"roleId":"ro_test","name":"Test","permissions":[]}`))
+			return
+		}
+		if r.Header.Get("If-Match") != `"v1"` {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.Header().Set("ETag", `"v2"`)
+		w.Write([]byte(`{"roleId":"ro_test","name":"Updated","permissions":[]}`))
+	})
+
+	role, err := client.GetRole(context.Background(), "ro_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role.Version != `"v1"` {
+		t.Fatalf("expected the ETag to be captured as the version, got %s", role.Version)
+	}
+
+	updatedRole, err := client.UpdateRole(context.Background(), "ro_test", *role)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updatedRole.Version != `"v2"` {
+		t.Fatalf("expected the new version to be returned, got %s", updatedRole.Version)
+	}
+
+	role.Version = `"v0"`
+	_, err = client.UpdateRole(context.Background(), "ro_test", *role)
+	if apiError, ok := AsAPIError(err); !ok || apiError.StatusCode != http.StatusPreconditionFailed {
+		t.Fatalf("expected the outdated version to be rejected, got %v", err)
+	}
+}
+
+func TestUpdateRoleSendsLastModifiedPrecondition(t *testing.T) {
+	lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Unmodified-Since") != lastModified || r.Header.Get("If-Match") != "" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.Write([]byte(`{"roleId":"ro_test","name":"Updated","permissions":[]}`))
+	})
+
+	_, err := client.UpdateRole(context.Background(), "ro_test", Role{ RoleID: "ro_test", Name: "Updated", Version: lastModified })
+	if err != nil {
+		t.Fatalf("expected the Last-Modified version to be sent as If-Unmodified-Since, got: %s", err)
+	}
+}
+
+func TestUpdateRoleWithoutVersion(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Match") != "" || r.Header.Get("If-Unmodified-Since") != "" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.Write([]byte(`{"roleId":"ro_test","name":"Test","permissions":[]}`))
+	})
+
+	role, err := client.GetRole(context.Background(), "ro_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role.Version != "" {
+		t.Fatalf("expected no version without an ETag or Last-Modified header, got %s", role.Version)
+	}
+
+	_, err = client.UpdateRole(context.Background(), "ro_test", *role)
+	if err != nil {
+		t.Fatalf("expected the update to be sent without a precondition, got: %s", err)
+	}
+}
+
+func TestDeleteRoleTreatsMissingRoleAsDeleted(t *testing.T) {
+	for _, status := range []int{ http.StatusNoContent, http.StatusNotFound, http.StatusGone } {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		})
+
+		if err := client.DeleteRole(context.Background(), "ro_test"); err != nil {
+			t.Fatalf("expected status %d to delete the role, got: %s", status, err)
+		}
+	}
+}
+
+func TestDeleteRoleReturnsFailures(t *testing.T) {
+	for _, status := range []int{ http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusConflict, http.StatusInternalServerError } {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"errorCode":"DeleteFailed","title":"The role could not be deleted"}`))
+		})
+		client.MaxRetries = 0
+
+		err := client.DeleteRole(context.Background(), "ro_test")
+		if apiError, ok := AsAPIError(err); !ok || apiError.StatusCode != status {
+			t.Fatalf("expected status %d to be returned as an error, got: %v", status, err)
+		}
+	}
+}
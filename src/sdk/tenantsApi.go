@@ -0,0 +1,100 @@
+package authress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) ListTenants(options ListOptions) (*PageIterator[Tenant]) {
+	return newPageIterator[Tenant](c, "/v1/tenants", "tenants", options)
+}
+
+func (c *Client) GetTenant(ctx context.Context, tenantID string) (*Tenant, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/tenants/%s", c.HostURL, tenantID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tenant := Tenant{}
+	err = json.Unmarshal(body, &tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+func (c *Client) CreateTenant(ctx context.Context, tenant Tenant) (*Tenant, error) {
+	rb, err := json.Marshal(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/tenants", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newTenant := Tenant{}
+	err = json.Unmarshal(body, &newTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newTenant, nil
+}
+
+func (c *Client) UpdateTenant(ctx context.Context, tenantID string, tenant Tenant) (*Tenant, error) {
+	rb, err := json.Marshal(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/v1/tenants/%s", c.HostURL, tenantID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newTenant := Tenant{}
+	err = json.Unmarshal(body, &newTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newTenant, nil
+}
+
+func (c *Client) DeleteTenant(ctx context.Context, tenantID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/tenants/%s", c.HostURL, tenantID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := c.doRequest(req)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
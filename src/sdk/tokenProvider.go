@@ -0,0 +1,161 @@
+package authress
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	serviceClientTokenLifetime	= 60 * time.Minute
+	serviceClientTokenRefresh	= 5 * time.Minute
+)
+
+// TokenProvider - Provides the bearer token sent to Authress with every request
+type TokenProvider interface {
+	GetToken(ctx context.Context) (string, error)
+}
+
+// StaticTokenProvider - Sends the configured token as is, used for tokens that were already issued by Authress
+type StaticTokenProvider struct {
+	Token	string
+}
+
+func (p *StaticTokenProvider) GetToken(_ context.Context) (string, error) {
+	return p.Token, nil
+}
+
+// ServiceClientTokenProvider - Signs short-lived EdDSA JWTs with the private key of a service client access key
+type ServiceClientTokenProvider struct {
+	ClientID	string
+	KeyID		string
+	AccountID	string
+	Issuer		string
+	privateKey	ed25519.PrivateKey
+	now			func() time.Time
+
+	mutex		sync.Mutex
+	token		string
+	expiresAt	time.Time
+}
+
+// NewTokenProvider returns a ServiceClientTokenProvider when the access key is a service client access key, otherwise the access key is used as the token.
+func NewTokenProvider(customDomain string, accessKey string) (TokenProvider, error) {
+	if len(strings.Split(accessKey, ".")) != 4 {
+		return &StaticTokenProvider{ Token: accessKey }, nil
+	}
+
+	return NewServiceClientTokenProvider(customDomain, accessKey)
+}
+
+// NewServiceClientTokenProvider parses the service client access key, which has the format clientId.keyId.accountId.privateKey
+func NewServiceClientTokenProvider(customDomain string, accessKey string) (*ServiceClientTokenProvider, error) {
+	accessKeyParts := strings.Split(accessKey, ".")
+	if len(accessKeyParts) != 4 || accessKeyParts[0] == "" || accessKeyParts[1] == "" || accessKeyParts[2] == "" {
+		return nil, fmt.Errorf("the access key is not a valid Authress service client access key")
+	}
+
+	privateKey, err := parseAccessKeyPrivateKey(accessKeyParts[3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceClientTokenProvider{
+		ClientID: accessKeyParts[0],
+		KeyID: accessKeyParts[1],
+		AccountID: accessKeyParts[2],
+		Issuer: getServiceClientIssuer(customDomain, accessKeyParts[0]),
+		privateKey: privateKey,
+		now: time.Now,
+	}, nil
+}
+
+// GetToken returns the cached token and only signs a new one when the cached token is about to expire.
+func (p *ServiceClientTokenProvider) GetToken(_ context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	if p.token != "" && now.Add(serviceClientTokenRefresh).Before(p.expiresAt) {
+		return p.token, nil
+	}
+
+	expiresAt := now.Add(serviceClientTokenLifetime)
+	token, err := p.signToken(now, expiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	p.token = token
+	p.expiresAt = expiresAt
+	return token, nil
+}
+
+func (p *ServiceClientTokenProvider) signToken(issuedAt time.Time, expiresAt time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "EdDSA",
+		"typ": "JWT",
+		"kid": p.KeyID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"iss": p.Issuer,
+		"sub": p.ClientID,
+		"client_id": p.ClientID,
+		"aud": fmt.Sprintf("%s.accounts.authress.io", p.AccountID),
+		"iat": issuedAt.Unix(),
+		"exp": expiresAt.Unix(),
+		"scope": "openid",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	signature := ed25519.Sign(p.privateKey, []byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parseAccessKeyPrivateKey accepts the base64 encoded PKCS8 private key of the access key, as well as a raw Ed25519 seed.
+func parseAccessKeyPrivateKey(encodedPrivateKey string) (ed25519.PrivateKey, error) {
+	decodedPrivateKey, err := base64.StdEncoding.DecodeString(encodedPrivateKey)
+	if err != nil {
+		decodedPrivateKey, err = base64.RawURLEncoding.DecodeString(encodedPrivateKey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the private key of the access key is not valid base64: %w", err)
+	}
+
+	if len(decodedPrivateKey) == ed25519.SeedSize {
+		return ed25519.NewKeyFromSeed(decodedPrivateKey), nil
+	}
+
+	parsedPrivateKey, err := x509.ParsePKCS8PrivateKey(decodedPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("the private key of the access key could not be parsed: %w", err)
+	}
+
+	privateKey, ok := parsedPrivateKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the private key of the access key is not an Ed25519 key")
+	}
+	return privateKey, nil
+}
+
+func getServiceClientIssuer(customDomain string, clientID string) (string) {
+	host := strings.TrimSuffix(customDomain, "/")
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "https://" + host
+	}
+	return fmt.Sprintf("%s/v1/clients/%s", host, url.PathEscape(clientID))
+}
@@ -0,0 +1,105 @@
+package authress
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestAccessKey(t *testing.T) (string, ed25519.PublicKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodedPrivateKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "sc_test.key_test.acc_test." + base64.StdEncoding.EncodeToString(encodedPrivateKey), publicKey
+}
+
+func TestServiceClientTokenProviderSignsToken(t *testing.T) {
+	accessKey, publicKey := newTestAccessKey(t)
+	tokenProvider, err := NewServiceClientTokenProvider("login.example.com", accessKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := tokenProvider.GetToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenParts := strings.Split(token, ".")
+	if len(tokenParts) != 3 {
+		t.Fatalf("expected a JWT, got %s", token)
+	}
+	signature, _ := base64.RawURLEncoding.DecodeString(tokenParts[2])
+	if !ed25519.Verify(publicKey, []byte(tokenParts[0] + "." + tokenParts[1]), signature) {
+		t.Fatal("expected the token to be signed by the access key")
+	}
+
+	var header map[string]any
+	headerBytes, _ := base64.RawURLEncoding.DecodeString(tokenParts[0])
+	json.Unmarshal(headerBytes, &header)
+	if header["alg"] != "EdDSA" || header["kid"] != "key_test" {
+		t.Fatalf("unexpected token header %v", header)
+	}
+
+	var claims map[string]any
+	claimsBytes, _ := base64.RawURLEncoding.DecodeString(tokenParts[1])
+	json.Unmarshal(claimsBytes, &claims)
+	if claims["iss"] != "https://login.example.com/v1/clients/sc_test" || claims["sub"] != "sc_test" || claims["aud"] != "acc_test.accounts.authress.io" {
+		t.Fatalf("unexpected token claims %v", claims)
+	}
+}
+
+func TestServiceClientTokenProviderRefreshesBeforeExpiry(t *testing.T) {
+	accessKey, _ := newTestAccessKey(t)
+	tokenProvider, err := NewServiceClientTokenProvider("https://login.example.com", accessKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	tokenProvider.now = func() time.Time { return now }
+
+	firstToken, _ := tokenProvider.GetToken(context.Background())
+	now = now.Add(serviceClientTokenLifetime - serviceClientTokenRefresh - time.Minute)
+	if cachedToken, _ := tokenProvider.GetToken(context.Background()); cachedToken != firstToken {
+		t.Fatal("expected the token to be cached until it is about to expire")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if refreshedToken, _ := tokenProvider.GetToken(context.Background()); refreshedToken == firstToken {
+		t.Fatal("expected the token to be refreshed before it expires")
+	}
+}
+
+func TestNewTokenProvider(t *testing.T) {
+	if _, ok := mustNewTokenProvider(t, "pre-issued-token").(*StaticTokenProvider); !ok {
+		t.Fatal("expected a token that is not an access key to be sent as is")
+	}
+
+	accessKey, _ := newTestAccessKey(t)
+	if _, ok := mustNewTokenProvider(t, accessKey).(*ServiceClientTokenProvider); !ok {
+		t.Fatal("expected an access key to be signed into tokens")
+	}
+
+	if _, err := NewTokenProvider("login.example.com", "sc_test.key_test.acc_test.not-a-key"); err == nil {
+		t.Fatal("expected an access key with an invalid private key to be rejected")
+	}
+}
+
+func mustNewTokenProvider(t *testing.T, accessKey string) (TokenProvider) {
+	tokenProvider, err := NewTokenProvider("login.example.com", accessKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokenProvider
+}
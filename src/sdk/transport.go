@@ -0,0 +1,75 @@
+package authress
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultRequestTimeout - Default time limit of a single request to the Authress API
+const DefaultRequestTimeout time.Duration = 10 * time.Second
+
+// TransportOptions - Network configuration used to reach the Authress API
+type TransportOptions struct {
+	RequestTimeout		time.Duration
+	ProxyURL			string
+	CABundleFile		string
+	CABundle			string
+	ClientCertificate	string
+	ClientKey			string
+}
+
+// newHTTPClient keeps the defaults of the Go HTTP client, such as the proxy environment variables and the system root CAs, unless they are overridden.
+func newHTTPClient(options TransportOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if options.ProxyURL != "" {
+		proxyURL, err := url.Parse(options.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("the proxy_url is not a valid URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{ MinVersion: tls.VersionTLS12 }
+	if options.CABundleFile != "" || options.CABundle != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		caBundle := options.CABundle
+		if options.CABundleFile != "" {
+			caBundleFile, err := ioutil.ReadFile(options.CABundleFile)
+			if err != nil {
+				return nil, fmt.Errorf("could not read the ca_bundle_file: %w", err)
+			}
+			caBundle += "\n" + string(caBundleFile)
+		}
+
+		if !rootCAs.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, fmt.Errorf("the CA bundle does not contain any PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if options.ClientCertificate != "" || options.ClientKey != "" {
+		clientCertificate, err := tls.X509KeyPair([]byte(options.ClientCertificate), []byte(options.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("the client_certificate and client_key are not a valid PEM encoded key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{ clientCertificate }
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	timeout := options.RequestTimeout
+	if timeout <= 0 {
+		timeout = DefaultRequestTimeout
+	}
+
+	return &http.Client{ Transport: &loggingRoundTripper{ next: transport }, Timeout: timeout }, nil
+}
@@ -0,0 +1,66 @@
+package authress
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientTrustsCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"roleId":"ro_test","name":"Test","permissions":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	caBundle := string(pem.EncodeToMemory(&pem.Block{ Type: "CERTIFICATE", Bytes: server.Certificate().Raw }))
+
+	untrustedClient, _ := NewClient(server.URL, "test-access-key", "0.0.0", TransportOptions{})
+	untrustedClient.MaxRetries = 0
+	if _, err := untrustedClient.GetRole(context.Background(), "ro_test"); err == nil {
+		t.Fatal("expected the certificate of the server not to be trusted by default")
+	}
+
+	trustedClient, err := NewClient(server.URL, "test-access-key", "0.0.0", TransportOptions{ CABundle: caBundle })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := trustedClient.GetRole(context.Background(), "ro_test"); err != nil {
+		t.Fatalf("expected the CA bundle to be trusted, got: %s", err)
+	}
+}
+
+func TestNewClientUsesProxy(t *testing.T) {
+	proxiedURLs := []string{}
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURLs = append(proxiedURLs, r.URL.String())
+		w.Write([]byte(`{"roleId":"ro_test","name":"Test","permissions":[]}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := NewClient("http://authress.example.com", "test-access-key", "0.0.0", TransportOptions{ ProxyURL: proxy.URL })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetRole(context.Background(), "ro_test"); err != nil {
+		t.Fatal(err)
+	}
+	if len(proxiedURLs) != 1 || proxiedURLs[0] != "http://authress.example.com/v1/roles/ro_test" {
+		t.Fatalf("expected the request to be sent through the proxy, got %v", proxiedURLs)
+	}
+}
+
+func TestNewClientRejectsInvalidTransportOptions(t *testing.T) {
+	if _, err := NewClient("https://login.example.com", "test-access-key", "0.0.0", TransportOptions{ CABundle: "not a certificate" }); err == nil {
+		t.Fatal("expected an invalid CA bundle to be rejected")
+	}
+	if _, err := NewClient("https://login.example.com", "test-access-key", "0.0.0", TransportOptions{ ClientCertificate: "not a certificate" }); err == nil {
+		t.Fatal("expected a client certificate without a valid key to be rejected")
+	}
+
+	client, _ := NewClient("https://login.example.com", "test-access-key", "0.0.0", TransportOptions{ RequestTimeout: time.Minute })
+	if client.HTTPClient.Timeout != time.Minute {
+		t.Fatalf("expected the request timeout to be used, got %s", client.HTTPClient.Timeout)
+	}
+}
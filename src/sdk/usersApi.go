@@ -0,0 +1,5 @@
+package authress
+
+func (c *Client) ListUsers(options ListOptions) (*PageIterator[User]) {
+	return newPageIterator[User](c, "/v1/users", "users", options)
+}
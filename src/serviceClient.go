@@ -0,0 +1,289 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ServiceClientInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &ServiceClientInterfaceProvider{}
+	_ resource.ResourceWithImportState = &ServiceClientInterfaceProvider{}
+)
+
+// NewServiceClientResource is a helper function to simplify the provider implementation.
+func NewServiceClientResource() resource.Resource {
+	return &ServiceClientInterfaceProvider{}
+}
+
+// ServiceClientInterfaceProvider is the resource implementation.
+type ServiceClientInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressServiceClientResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID					TerraformType.String	`tfsdk:"id"`
+	ClientID					TerraformType.String	`tfsdk:"client_id"`
+	Name						TerraformType.String	`tfsdk:"name"`
+	Description					TerraformType.String	`tfsdk:"description"`
+	LastUpdated					TerraformType.String	`tfsdk:"last_updated"`
+	RedirectURLs				TerraformType.List		`tfsdk:"redirect_urls"`
+	GrantUserPermissionsAccess	TerraformType.Bool		`tfsdk:"grant_user_permissions_access"`
+	GrantMetadataAccess			TerraformType.Bool		`tfsdk:"grant_metadata_access"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *ServiceClientInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_client"
+}
+
+// Schema defines the schema for the data source.
+func (r *ServiceClientInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Service Client`. Service Clients are the identities of your backend services, and authenticate to Authress using their `Access Keys`. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Service Client`. Service Clients are the identities of your backend services, and authenticate to Authress using their `Access Keys`. See [Service Clients](https://authress.io/knowledge-base/docs/authorization/service-clients) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"client_id": schema.StringAttribute {
+				Description:	"Unique identifier for the service client, generated by Authress on creation.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the service client.",
+				Computed:   	true,
+			},
+			"name": schema.StringAttribute {
+				Description:	"A helpful name for this service client. The name displays in the Authress Management Portal",
+				Required:   	true,
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute {
+				Description:	"An extended description field that can be used to store additional information about the usage of the service client.",
+				Optional:	    true,
+				Computed:		true,
+				Validators: 	[]validator.String{
+					stringvalidator.LengthBetween(0, 1024),
+				},
+			},
+			"redirect_urls": schema.ListAttribute {
+				Description:	"The list of URLs the service client is allowed to redirect users to when it is used to log users in.",
+				ElementType:	TerraformType.StringType,
+				Optional:		true,
+				Computed:		true,
+			},
+			"grant_user_permissions_access": schema.BoolAttribute {
+				Description:	"Trusts the service client to check the permissions of any user, not only the permissions granted to the service client itself.",
+				Optional: 		true,
+				Computed:		true,
+				PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
+			},
+			"grant_metadata_access": schema.BoolAttribute {
+				Description:	"Trusts the service client to read and update the metadata of any user.",
+				Optional: 		true,
+				Computed:		true,
+				PlanModifiers: []planmodifier.Bool{ boolDefault(false) },
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ServiceClientInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ServiceClientInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressServiceClientResource AuthressServiceClientResource
+	diags := req.Plan.Get(ctx, &plannedAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new service client
+	authressSdkServiceClient := MapTerraformServiceClientToSdk(&plannedAuthressServiceClientResource)
+	returnedServiceClient, err := r.client.CreateServiceClient(ctx, authressSdkServiceClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create service client:",
+			GetApiErrorWrapper("Could not create service client", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressServiceClientResource = MapSdkServiceClientToTerraform(returnedServiceClient)
+	plannedAuthressServiceClientResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ServiceClientInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressServiceClientResource AuthressServiceClientResource
+	diags := req.State.Get(ctx, &currentAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed service client value from Authress
+	authressSdkServiceClient, err := r.client.GetServiceClient(ctx, currentAuthressServiceClientResource.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get service client:",
+			GetApiErrorWrapper("Could not read Authress service client ID " + currentAuthressServiceClientResource.ClientID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkServiceClient == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Service Client exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the service client in the Authress Management Portal or remove it from your state file. Client ID:" + currentAuthressServiceClientResource.ClientID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Service Client", currentAuthressServiceClientResource.ClientID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressServiceClientResource
+	currentAuthressServiceClientResource = MapSdkServiceClientToTerraform(authressSdkServiceClient)
+	diags = resp.State.Set(ctx, &currentAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ServiceClientInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressServiceClientResource AuthressServiceClientResource
+	diags := req.Plan.Get(ctx, &plannedAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressServiceClientResource
+	authressSdkServiceClient := MapTerraformServiceClientToSdk(&plannedAuthressServiceClientResource)
+
+	// Update existing service client
+	returnedServiceClient, err := r.client.UpdateServiceClient(ctx, plannedAuthressServiceClientResource.ClientID.ValueString(), authressSdkServiceClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update service client:",
+			GetApiErrorWrapper("Could not update service client", err),
+		)
+		return
+	}
+
+	plannedAuthressServiceClientResource = MapSdkServiceClientToTerraform(returnedServiceClient)
+	plannedAuthressServiceClientResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ServiceClientInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressServiceClientResource AuthressServiceClientResource
+	diags := req.State.Get(ctx, &currentAuthressServiceClientResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing service client
+	err := r.client.DeleteServiceClient(ctx, currentAuthressServiceClientResource.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete service client:",
+			GetApiErrorWrapper("Could not delete service client", err),
+		)
+		return
+	}
+}
+
+func (r *ServiceClientInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("client_id"), req, resp)
+}
+
+func MapSdkServiceClientToTerraform(authressSdkServiceClient *AuthressSdk.ServiceClient) (AuthressServiceClientResource) {
+	terraformServiceClient := AuthressServiceClientResource {
+		ClientID: TerraformType.StringValue(authressSdkServiceClient.ClientID),
+		LegacyID: TerraformType.StringValue(authressSdkServiceClient.ClientID),
+		Name: TerraformType.StringValue(authressSdkServiceClient.Name),
+		Description: TerraformType.StringValue(authressSdkServiceClient.Description),
+		RedirectURLs: MapSdkStringListToTerraform(authressSdkServiceClient.RedirectURLs),
+		GrantUserPermissionsAccess: TerraformType.BoolValue(false),
+		GrantMetadataAccess: TerraformType.BoolValue(false),
+	}
+
+	if authressSdkServiceClient.Options != nil {
+		terraformServiceClient.GrantUserPermissionsAccess = TerraformType.BoolValue(authressSdkServiceClient.Options.GrantUserPermissionsAccess)
+		terraformServiceClient.GrantMetadataAccess = TerraformType.BoolValue(authressSdkServiceClient.Options.GrantMetadataAccess)
+	}
+
+	return terraformServiceClient
+}
+
+func MapTerraformServiceClientToSdk(terraformServiceClient *AuthressServiceClientResource) (AuthressSdk.ServiceClient) {
+	return AuthressSdk.ServiceClient {
+		Name: terraformServiceClient.Name.ValueString(),
+		Description: terraformServiceClient.Description.ValueString(),
+		RedirectURLs: MapTerraformStringListToSdk(terraformServiceClient.RedirectURLs),
+		Options: &AuthressSdk.ServiceClientOptions {
+			GrantUserPermissionsAccess: terraformServiceClient.GrantUserPermissionsAccess.ValueBool(),
+			GrantMetadataAccess: terraformServiceClient.GrantMetadataAccess.ValueBool(),
+		},
+	}
+}
@@ -0,0 +1,214 @@
+package authress
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ServiceClientAccessKeyInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &ServiceClientAccessKeyInterfaceProvider{}
+)
+
+// NewServiceClientAccessKeyResource is a helper function to simplify the provider implementation.
+func NewServiceClientAccessKeyResource() resource.Resource {
+	return &ServiceClientAccessKeyInterfaceProvider{}
+}
+
+// ServiceClientAccessKeyInterfaceProvider is the resource implementation.
+type ServiceClientAccessKeyInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressServiceClientAccessKeyResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID	TerraformType.String	`tfsdk:"id"`
+	ClientID	TerraformType.String	`tfsdk:"client_id"`
+	KeyID		TerraformType.String	`tfsdk:"key_id"`
+	AccessKey	TerraformType.String	`tfsdk:"access_key"`
+	LastUpdated	TerraformType.String	`tfsdk:"last_updated"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *ServiceClientAccessKeyInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_client_access_key"
+}
+
+// Schema defines the schema for the data source.
+func (r *ServiceClientAccessKeyInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an `Access Key` of an Authress `Service Client`. The generated access key is only available when the key is created, and the key is revoked when the resource is destroyed. See Authress KB for more information.",
+		MarkdownDescription: "Manages an `Access Key` of an Authress `Service Client`. The generated access key is only available when the key is created, and the key is revoked when the resource is destroyed. See [Service Clients](https://authress.io/knowledge-base/docs/authorization/service-clients) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"client_id": schema.StringAttribute {
+				Description:	"The ID of the service client the access key belongs to.",
+				Required:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.RequiresReplace() },
+			},
+			"key_id": schema.StringAttribute {
+				Description:	"Unique identifier for the access key, generated by Authress on creation.",
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the access key.",
+				Computed:   	true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+			"access_key": schema.StringAttribute {
+				Description:	"The generated access key. It is only returned by Authress when the key is created, store it directly in your secret manager.",
+				Computed:		true,
+				Sensitive:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ServiceClientAccessKeyInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ServiceClientAccessKeyInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressAccessKeyResource AuthressServiceClientAccessKeyResource
+	diags := req.Plan.Get(ctx, &plannedAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new access key
+	returnedAccessKey, err := r.client.CreateServiceClientAccessKey(ctx, plannedAuthressAccessKeyResource.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create service client access key:",
+			GetApiErrorWrapper("Could not create service client access key", err),
+		)
+		return
+	}
+
+	// Populate Computed attribute values, the access key is only ever returned here
+	plannedAuthressAccessKeyResource.KeyID = TerraformType.StringValue(returnedAccessKey.KeyID)
+	plannedAuthressAccessKeyResource.LegacyID = TerraformType.StringValue(returnedAccessKey.KeyID)
+	plannedAuthressAccessKeyResource.AccessKey = TerraformType.StringValue(returnedAccessKey.AccessKey)
+	plannedAuthressAccessKeyResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ServiceClientAccessKeyInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressAccessKeyResource AuthressServiceClientAccessKeyResource
+	diags := req.State.Get(ctx, &currentAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed service client value from Authress, the access keys are listed on the service client
+	authressSdkServiceClient, err := r.client.GetServiceClient(ctx, currentAuthressAccessKeyResource.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get service client:",
+			GetApiErrorWrapper("Could not read Authress service client ID " + currentAuthressAccessKeyResource.ClientID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkServiceClient == nil || !hasServiceClientAccessKey(authressSdkServiceClient, currentAuthressAccessKeyResource.KeyID.ValueString()) {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Service Client Access Key exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Remove the access key from your state file to generate a new one. Key ID:" + currentAuthressAccessKeyResource.KeyID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Service Client Access Key", currentAuthressAccessKeyResource.KeyID.ValueString())
+		return
+	}
+
+	// The access key cannot be refreshed, the current state is already up to date
+	diags = resp.State.Set(ctx, &currentAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, every attribute change of the access key requires a replacement.
+func (r *ServiceClientAccessKeyInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedAuthressAccessKeyResource AuthressServiceClientAccessKeyResource
+	diags := req.Plan.Get(ctx, &plannedAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plannedAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes the access key and removes the Terraform state on success.
+func (r *ServiceClientAccessKeyInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressAccessKeyResource AuthressServiceClientAccessKeyResource
+	diags := req.State.Get(ctx, &currentAuthressAccessKeyResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revoke existing access key
+	err := r.client.DeleteServiceClientAccessKey(ctx, currentAuthressAccessKeyResource.ClientID.ValueString(), currentAuthressAccessKeyResource.KeyID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete service client access key:",
+			GetApiErrorWrapper("Could not delete service client access key", err),
+		)
+		return
+	}
+}
+
+func hasServiceClientAccessKey(authressSdkServiceClient *AuthressSdk.ServiceClient, keyID string) (bool) {
+	for _, accessKey := range authressSdkServiceClient.AccessKeys {
+		if accessKey.KeyID == keyID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package authress
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestServiceClientResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_service_client" "test-1" {
+	name = "Terraform Test Service Client"
+}
+
+resource "authress_service_client_access_key" "test-1" {
+	client_id = authress_service_client.test-1.client_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("authress_service_client.test-1", "client_id"),
+					resource.TestCheckResourceAttr("authress_service_client.test-1", "grant_user_permissions_access", "false"),
+					resource.TestCheckResourceAttrSet("authress_service_client_access_key.test-1", "key_id"),
+					resource.TestCheckResourceAttrSet("authress_service_client_access_key.test-1", "access_key"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_service_client.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_service_client" "test-1" {
+	name = "Terraform Test Service Client Updated"
+	grant_user_permissions_access = true
+}
+
+resource "authress_service_client_access_key" "test-1" {
+	client_id = authress_service_client.test-1.client_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_service_client.test-1", "name", "Terraform Test Service Client Updated"),
+					resource.TestCheckResourceAttr("authress_service_client.test-1", "grant_user_permissions_access", "true"),
+					resource.TestCheckResourceAttrSet("authress_service_client_access_key.test-1", "access_key"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
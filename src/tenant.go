@@ -0,0 +1,302 @@
+package authress
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+
+	AuthressSdk "github.com/authress/terraform-provider-authress/src/sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TenantInterfaceProvider{}
+	_ resource.ResourceWithConfigure   = &TenantInterfaceProvider{}
+	_ resource.ResourceWithImportState = &TenantInterfaceProvider{}
+)
+
+// NewTenantResource is a helper function to simplify the provider implementation.
+func NewTenantResource() resource.Resource {
+	return &TenantInterfaceProvider{}
+}
+
+// TenantInterfaceProvider is the resource implementation.
+type TenantInterfaceProvider struct {
+	client *AuthressSdk.Client
+	strictRead bool
+}
+
+/*******************************************/
+/* Data stored in Terraform State and Plan */
+/*******************************************/
+type AuthressTenantResource struct {
+	// Remove after https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute https://github.com/hashicorp/terraform-plugin-sdk/issues/1072
+	LegacyID				TerraformType.String					`tfsdk:"id"`
+	TenantID				TerraformType.String					`tfsdk:"tenant_id"`
+	TenantLookupIdentifier	TerraformType.String					`tfsdk:"tenant_lookup_identifier"`
+	LastUpdated				TerraformType.String					`tfsdk:"last_updated"`
+	Data					*AuthressTenantDataResource				`tfsdk:"data"`
+	ConnectionID			TerraformType.String					`tfsdk:"connection_id"`
+}
+
+type AuthressTenantDataResource struct {
+	Name	TerraformType.String	`tfsdk:"name"`
+}
+/*******************************************/
+/*******************************************/
+
+// Metadata returns the data source type name.
+func (r *TenantInterfaceProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant"
+}
+
+// Schema defines the schema for the data source.
+func (r *TenantInterfaceProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Authress `Tenant`. Tenants represent your customers, and link the users of each customer to their own SSO `Connection`. See Authress KB for more information.",
+		MarkdownDescription: "Manages an Authress `Tenant`. Tenants represent your customers, and link the users of each customer to their own SSO `Connection`. See [Tenants](https://authress.io/knowledge-base/docs/authentication/user-authentication/enterprise-sso) for more information.",
+		Attributes: map[string]schema.Attribute {
+			"tenant_id": schema.StringAttribute {
+				Description: "Unique identifier for the tenant, can be specified on creation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{ stringplanmodifier.RequiresReplace() },
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9-._:@]+$`),
+						"must contain only alphanumeric characters and [-._:@]",
+					),
+				},
+			},
+			"id": schema.StringAttribute {
+				Description:	"Legacy Terraform property that is not actually used",
+				Computed:   	true,
+			},
+			"last_updated": schema.StringAttribute {
+				Description:	"Timestamp of the last Terraform update of the tenant.",
+				Computed:   	true,
+			},
+			"tenant_lookup_identifier": schema.StringAttribute {
+				Description:	"The identifier used to find the tenant during login, for example the domain of the customer's email addresses. When not specified, Authress generates one.",
+				Optional:		true,
+				Computed:		true,
+				PlanModifiers:	[]planmodifier.String{ stringplanmodifier.UseStateForUnknown() },
+				Validators:		[]validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"data": schema.SingleNestedAttribute {
+				Description:	"Display data for the tenant, shown to users during login and in the Authress Management Portal.",
+				Optional:		true,
+				Attributes: map[string]schema.Attribute {
+					"name": schema.StringAttribute {
+						Description:	"A helpful name for this tenant, usually the name of the customer.",
+						Required:		true,
+						Validators:		[]validator.String{
+							stringvalidator.LengthBetween(1, 128),
+						},
+					},
+				},
+			},
+			"connection_id": schema.StringAttribute {
+				Description:	"The ID of the SSO connection users of this tenant log in with, for example `authress_connection.customer.connection_id`.",
+				Optional:		true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *TenantInterfaceProvider) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*AuthressProviderData)
+	r.client = providerData.Client
+	r.strictRead = providerData.StrictRead
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TenantInterfaceProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressTenantResource AuthressTenantResource
+	diags := req.Plan.Get(ctx, &plannedAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new tenant
+	authressSdkTenant := MapTerraformTenantToSdk(&plannedAuthressTenantResource)
+	returnedTenant, err := r.client.CreateTenant(ctx, authressSdkTenant)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to create tenant:",
+			GetCreateApiErrorWrapper("Could not create tenant", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedAuthressTenantResource = MapSdkTenantToTerraform(returnedTenant)
+	plannedAuthressTenantResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plannedAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TenantInterfaceProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var currentAuthressTenantResource AuthressTenantResource
+	diags := req.State.Get(ctx, &currentAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed tenant value from Authress
+	authressSdkTenant, err := r.client.GetTenant(ctx, currentAuthressTenantResource.TenantID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to get tenant:",
+			GetApiErrorWrapper("Could not read Authress tenant ID " + currentAuthressTenantResource.TenantID.ValueString(), err),
+		)
+		return
+	}
+
+	if authressSdkTenant == nil {
+		if r.strictRead {
+			resp.Diagnostics.AddError(
+				"Authress Tenant exists in the Terraform plan but does not exist in Authress:",
+				GetErrorWrapper("Either recreate the tenant in the Authress Management Portal or remove it from your state file. Tenant ID:" + currentAuthressTenantResource.TenantID.ValueString()),
+			)
+			return
+		}
+		RemoveDeletedResource(ctx, resp, "Tenant", currentAuthressTenantResource.TenantID.ValueString())
+		return
+	}
+
+	// Set refreshed currentAuthressTenantResource
+	currentAuthressTenantResource = MapSdkTenantToTerraform(authressSdkTenant)
+	diags = resp.State.Set(ctx, &currentAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *TenantInterfaceProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plannedAuthressTenantResource AuthressTenantResource
+	diags := req.Plan.Get(ctx, &plannedAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plannedAuthressTenantResource
+	authressSdkTenant := MapTerraformTenantToSdk(&plannedAuthressTenantResource)
+
+	// Update existing tenant
+	returnedTenant, err := r.client.UpdateTenant(ctx, plannedAuthressTenantResource.TenantID.ValueString(), authressSdkTenant)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to update tenant:",
+			GetApiErrorWrapper("Could not update tenant", err),
+		)
+		return
+	}
+
+	plannedAuthressTenantResource = MapSdkTenantToTerraform(returnedTenant)
+	plannedAuthressTenantResource.LastUpdated = TerraformType.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plannedAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TenantInterfaceProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var currentAuthressTenantResource AuthressTenantResource
+	diags := req.State.Get(ctx, &currentAuthressTenantResource)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing tenant
+	err := r.client.DeleteTenant(ctx, currentAuthressTenantResource.TenantID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authress API Response: Attempted to delete tenant:",
+			GetApiErrorWrapper("Could not delete tenant", err),
+		)
+		return
+	}
+}
+
+func (r *TenantInterfaceProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("tenant_id"), req, resp)
+}
+
+func MapSdkTenantToTerraform(authressSdkTenant *AuthressSdk.Tenant) (AuthressTenantResource) {
+	terraformTenant := AuthressTenantResource {
+		TenantID: TerraformType.StringValue(authressSdkTenant.TenantID),
+		LegacyID: TerraformType.StringValue(authressSdkTenant.TenantID),
+		TenantLookupIdentifier: TerraformType.StringValue(authressSdkTenant.TenantLookupIdentifier),
+	}
+
+	if authressSdkTenant.Data != nil && authressSdkTenant.Data.Name != "" {
+		terraformTenant.Data = &AuthressTenantDataResource {
+			Name: TerraformType.StringValue(authressSdkTenant.Data.Name),
+		}
+	}
+
+	terraformTenant.ConnectionID = TerraformType.StringNull()
+	if authressSdkTenant.Connection != nil {
+		terraformTenant.ConnectionID = MapSdkOptionalStringToTerraform(authressSdkTenant.Connection.ConnectionID)
+	}
+
+	return terraformTenant
+}
+
+func MapTerraformTenantToSdk(terraformTenant *AuthressTenantResource) (AuthressSdk.Tenant) {
+	authressSdkTenant := AuthressSdk.Tenant {
+		TenantID: terraformTenant.TenantID.ValueString(),
+		TenantLookupIdentifier: terraformTenant.TenantLookupIdentifier.ValueString(),
+	}
+
+	if terraformTenant.Data != nil {
+		authressSdkTenant.Data = &AuthressSdk.TenantData {
+			Name: terraformTenant.Data.Name.ValueString(),
+		}
+	}
+
+	if terraformTenant.ConnectionID.ValueString() != "" {
+		authressSdkTenant.Connection = &AuthressSdk.TenantConnection {
+			ConnectionID: terraformTenant.ConnectionID.ValueString(),
+		}
+	}
+
+	return authressSdkTenant
+}
@@ -0,0 +1,94 @@
+package authress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestTenantResource(t *testing.T) {
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_tenant" "test-1" {
+	tenant_id = "test-tenant-1"
+	tenant_lookup_identifier = "test-1.example.com"
+	data = {
+		name = "Terraform Test Tenant"
+	}
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_tenant.test-1", "tenant_id", "test-tenant-1"),
+					resource.TestCheckResourceAttr("authress_tenant.test-1", "data.name", "Terraform Test Tenant"),
+					resource.TestCheckResourceAttrSet("authress_tenant.test-1", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "authress_tenant.test-1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the Authress API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "authress_tenant" "test-1" {
+	tenant_id = "test-tenant-1"
+	tenant_lookup_identifier = "test-1.example.com"
+	data = {
+		name = "Terraform Test Tenant Updated"
+	}
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_tenant.test-1", "data.name", "Terraform Test Tenant Updated"),
+					resource.TestCheckResourceAttrSet("authress_tenant.test-1", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestTenantResourceKeepsGeneratedLookupIdentifier(t *testing.T) {
+	if testServer == nil {
+		t.Skip("Generating the tenant lookup identifier requires the in memory Authress API")
+	}
+
+	config := func(name string) (string) {
+		return providerConfig + fmt.Sprintf(`
+resource "authress_tenant" "test-2" {
+	tenant_id = "test-tenant-2"
+	data = {
+		name = "%s"
+	}
+}`, name)
+	}
+
+	resource.Test(t, resource.TestCase {
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Terraform Test Tenant 2"),
+			},
+			// The lookup identifier generated by Authress is kept when only the data changes
+			{
+				PreConfig: func() {
+					tenant, _ := testServer.Get("tenants", "test-tenant-2")
+					tenant["tenantLookupIdentifier"] = "generated-lookup-identifier"
+					testServer.Set("tenants", tenant)
+				},
+				Config: config("Terraform Test Tenant 2 Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("authress_tenant.test-2", "data.name", "Terraform Test Tenant 2 Updated"),
+					resource.TestCheckResourceAttr("authress_tenant.test-2", "tenant_lookup_identifier", "generated-lookup-identifier"),
+				),
+			},
+		},
+	})
+}
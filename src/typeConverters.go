@@ -0,0 +1,37 @@
+package authress
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	TerraformType "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MapTerraformStringListToSdk converts a Terraform list of strings to the plain strings used by the Authress SDK. Null and unknown lists convert to an empty list.
+func MapTerraformStringListToSdk(terraformList TerraformType.List) ([]string) {
+	sdkList := make([]string, 0, len(terraformList.Elements()))
+	for _, element := range terraformList.Elements() {
+		if value, ok := element.(TerraformType.String); ok {
+			sdkList = append(sdkList, value.ValueString())
+		}
+	}
+
+	return sdkList
+}
+
+// MapSdkStringListToTerraform converts a list of strings returned by the Authress SDK to a Terraform list of strings.
+func MapSdkStringListToTerraform(sdkList []string) (TerraformType.List) {
+	terraformElements := make([]attr.Value, 0, len(sdkList))
+	for _, value := range sdkList {
+		terraformElements = append(terraformElements, TerraformType.StringValue(value))
+	}
+
+	return TerraformType.ListValueMust(TerraformType.StringType, terraformElements)
+}
+
+// MapSdkOptionalStringToTerraform converts an optional string returned by the Authress SDK to a Terraform string, where an empty value is stored as null.
+func MapSdkOptionalStringToTerraform(sdkValue string) (TerraformType.String) {
+	if sdkValue == "" {
+		return TerraformType.StringNull()
+	}
+
+	return TerraformType.StringValue(sdkValue)
+}